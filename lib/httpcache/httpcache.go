@@ -0,0 +1,94 @@
+// Package httpcache implements conditional requests and evaluates caching headers of upstream APIs such as ESI.
+package httpcache
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type contextKey int
+
+// Key for storing an ETag in a request's context
+const etagKey contextKey = 0
+
+// Transport adds an If-None-Match header to requests carrying an ETag in their context.
+type Transport struct {
+	Base http.RoundTripper
+}
+
+// NewTransport wraps a transport for making conditional requests.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base}
+}
+
+// RoundTrip executes a single request, making it conditional if it carries an ETag.
+func (transport *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	etag, ok := request.Context().Value(etagKey).(string)
+	if ok && etag != "" {
+		// Do not modify the original request
+		request = request.WithContext(request.Context())
+		request.Header = cloneHeader(request.Header)
+		request.Header.Set("If-None-Match", etag)
+	}
+
+	base := transport.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(request)
+}
+
+// WithETag returns a context which makes requests conditional, the server will answer with 304 if the resource still
+// has the given ETag. Empty ETags are ignored.
+func WithETag(ctx context.Context, etag string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if etag == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, etagKey, etag)
+}
+
+// NotModified checks whether a response indicates that the cached version of a resource is still up to date.
+func NotModified(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotModified
+}
+
+// ETag returns a response's ETag, empty if there is none.
+func ETag(response *http.Response) string {
+	if response == nil {
+		return ""
+	}
+
+	return response.Header.Get("ETag")
+}
+
+// ExpiresAt returns the UNIX timestamp a response expires at according to its Expires header. If the header is
+// missing, invalid or in the past the fallback is added to the current time instead.
+func ExpiresAt(response *http.Response, fallback time.Duration) int64 {
+	now := time.Now()
+
+	if response != nil {
+		expires, err := http.ParseTime(response.Header.Get("Expires"))
+		if err == nil && expires.After(now) {
+			return expires.Unix()
+		}
+	}
+
+	return now.Add(fallback).Unix()
+}
+
+// Copy a header so it can be modified safely
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+
+	return clone
+}
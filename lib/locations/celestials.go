@@ -0,0 +1,218 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Loads a single kind of celestial from ESI, returns the celestial and the ID of its solar system.
+type celestialLoader func(ctx context.Context, id int32) (pb.Celestial, int64, *http.Response, error)
+
+var celestialLoaders = map[pb.Celestial_Kind]celestialLoader{
+	pb.Celestial_PLANET:        loadPlanet,
+	pb.Celestial_MOON:          loadMoon,
+	pb.Celestial_ASTEROID_BELT: loadAsteroidBelt,
+	pb.Celestial_STARGATE:      loadStargate,
+}
+
+// Planets, moons and asteroid belts share an ID range, ESI's endpoints are probed in this order. Moons are by far the
+// most common kind in this range.
+var celestialProbeOrder = []pb.Celestial_Kind{
+	pb.Celestial_MOON,
+	pb.Celestial_PLANET,
+	pb.Celestial_ASTEROID_BELT,
+}
+
+// Celestials (planets, moons, asteroid belts and stargates) from ESI's universe API.
+type esiCelestialSource struct{}
+
+func (esiCelestialSource) Name() string {
+	return "esi-celestial"
+}
+
+func (esiCelestialSource) Claims(id int64) bool {
+	return id >= 40000000 && id < 60000000
+}
+
+func (esiCelestialSource) Fetch(ctx context.Context, id int64) (CachedLocation, error) {
+	return fetchCelestial(ctx, id)
+}
+
+func (esiCelestialSource) Refresh() {}
+
+func (esiCelestialSource) RefreshOnAccess(id int64) bool {
+	return true
+}
+
+func (esiCelestialSource) TTL(id int64) time.Duration {
+	return defaultTTL
+}
+
+// Fetch a celestial from ESI, concurrent fetches of the same ID are coalesced.
+func fetchCelestial(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, loadCelestial)
+}
+
+// Load a celestial from cache or ESI, use fetchCelestial instead of calling this.
+func loadCelestial(ctx context.Context, id int64) (CachedLocation, error) {
+	// Check if recent version is available in cache
+	cachedCelestial, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Return cached version
+	if !needsUpdate {
+		return cachedCelestial, nil
+	}
+
+	// IDs which are no celestial known to ESI (stars...) are not probed again until the entry expires
+	unknown, err := isUnknownLocation(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+	if unknown {
+		return CachedLocation{}, unknownLocationError(id)
+	}
+
+	logrus.WithField("celestial_id", id).Debug("Loading celestial from ESI.")
+
+	for _, kind := range celestialKinds(id, cachedCelestial) {
+		// Fetch from ESI if not in cache or modified
+		celestial, systemID, response, err := celestialLoaders[kind](conditionalContext(ctx, cachedCelestial), int32(id))
+		if httpcache.NotModified(response) {
+			return extendExpiry(cachedCelestial, response, sourceTTL(id)), nil
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			// Not this kind of celestial, try next one
+			continue
+		}
+		if err != nil {
+			return CachedLocation{}, err
+		}
+
+		// Get solar system
+		solarSystem, err := fetchSolarSystem(ctx, systemID)
+		if err != nil {
+			return CachedLocation{}, err
+		}
+
+		location := pb.Location(solarSystem.Location)
+		location.Celestial = &celestial
+
+		return newCachedLocation(id, location, response, sourceTTL(id)), nil
+	}
+
+	err = rememberUnknownLocation(id, time.Now().Add(unknownLocationTTL))
+	if err != nil {
+		logrus.WithError(err).Warnf("Could not remember unknown celestial %d", id)
+	}
+
+	msg := fmt.Sprintf("No celestial with ID %d found", id)
+	return CachedLocation{}, &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN, Err: errors.New(msg)}
+}
+
+// Get the kinds of celestial an ID could be, known kinds are not probed again.
+func celestialKinds(id int64, cachedCelestial CachedLocation) []pb.Celestial_Kind {
+	if id >= 50000000 {
+		return []pb.Celestial_Kind{pb.Celestial_STARGATE}
+	}
+
+	if cachedCelestial.Location.Celestial != nil {
+		return []pb.Celestial_Kind{cachedCelestial.Location.Celestial.Kind}
+	}
+
+	return celestialProbeOrder
+}
+
+func loadPlanet(ctx context.Context, id int32) (pb.Celestial, int64, *http.Response, error) {
+	planet, response, err := esiClient.ESI.UniverseApi.GetUniversePlanetsPlanetId(ctx, id, nil)
+	if err != nil {
+		return pb.Celestial{}, 0, response, err
+	}
+
+	celestial := pb.Celestial{
+		Id:     int64(planet.PlanetId),
+		Name:   planet.Name,
+		Kind:   pb.Celestial_PLANET,
+		TypeId: int64(planet.TypeId),
+		Coordinates: &pb.Coordinates{
+			X: float64(planet.Position.X),
+			Y: float64(planet.Position.Y),
+			Z: float64(planet.Position.Z),
+		},
+	}
+
+	return celestial, int64(planet.SystemId), response, nil
+}
+
+func loadMoon(ctx context.Context, id int32) (pb.Celestial, int64, *http.Response, error) {
+	moon, response, err := esiClient.ESI.UniverseApi.GetUniverseMoonsMoonId(ctx, id, nil)
+	if err != nil {
+		return pb.Celestial{}, 0, response, err
+	}
+
+	celestial := pb.Celestial{
+		Id:   int64(moon.MoonId),
+		Name: moon.Name,
+		Kind: pb.Celestial_MOON,
+		Coordinates: &pb.Coordinates{
+			X: float64(moon.Position.X),
+			Y: float64(moon.Position.Y),
+			Z: float64(moon.Position.Z),
+		},
+	}
+
+	return celestial, int64(moon.SystemId), response, nil
+}
+
+func loadAsteroidBelt(ctx context.Context, id int32) (pb.Celestial, int64, *http.Response, error) {
+	belt, response, err := esiClient.ESI.UniverseApi.GetUniverseAsteroidBeltsAsteroidBeltId(ctx, id, nil)
+	if err != nil {
+		return pb.Celestial{}, 0, response, err
+	}
+
+	// ESI does not return the belt's ID
+	celestial := pb.Celestial{
+		Id:   int64(id),
+		Name: belt.Name,
+		Kind: pb.Celestial_ASTEROID_BELT,
+		Coordinates: &pb.Coordinates{
+			X: float64(belt.Position.X),
+			Y: float64(belt.Position.Y),
+			Z: float64(belt.Position.Z),
+		},
+	}
+
+	return celestial, int64(belt.SystemId), response, nil
+}
+
+func loadStargate(ctx context.Context, id int32) (pb.Celestial, int64, *http.Response, error) {
+	stargate, response, err := esiClient.ESI.UniverseApi.GetUniverseStargatesStargateId(ctx, id, nil)
+	if err != nil {
+		return pb.Celestial{}, 0, response, err
+	}
+
+	celestial := pb.Celestial{
+		Id:     int64(stargate.StargateId),
+		Name:   stargate.Name,
+		Kind:   pb.Celestial_STARGATE,
+		TypeId: int64(stargate.TypeId),
+		Coordinates: &pb.Coordinates{
+			X: float64(stargate.Position.X),
+			Y: float64(stargate.Position.Y),
+			Z: float64(stargate.Position.Z),
+		},
+		DestinationSystemId:   int64(stargate.Destination.SystemId),
+		DestinationStargateId: int64(stargate.Destination.StargateId),
+	}
+
+	return celestial, int64(stargate.SystemId), response, nil
+}
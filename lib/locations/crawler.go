@@ -0,0 +1,200 @@
+package locations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/sirupsen/logrus"
+)
+
+// Set while a crawl is running, crawls are never run concurrently
+var crawling bool
+var crawlingMutex sync.Mutex
+
+// Set if the crawler is enabled, otherwise regions are refreshed along with the other sources
+var crawlerEnabled bool
+
+// Keep crawling in own goroutine, an interval of zero disables the crawler.
+func scheduleCrawl(interval time.Duration, concurrency int) {
+	if interval <= 0 {
+		logrus.Info("Universe crawler disabled.")
+		return
+	}
+
+	// Crawl on start...
+	go crawlUniverse(concurrency)
+
+	// ...then after every interval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		go crawlUniverse(concurrency)
+	}
+}
+
+// Walk regions, constellations, solar systems and NPC stations level by level and make sure all of them are cached.
+// Entries which are still valid are not requested from ESI, expired ones are refreshed using conditional requests.
+func crawlUniverse(concurrency int) {
+	crawlingMutex.Lock()
+	if crawling {
+		crawlingMutex.Unlock()
+		logrus.Warn("Previous universe crawl still running, skipping.")
+		return
+	}
+	crawling = true
+	crawlingMutex.Unlock()
+
+	defer func() {
+		crawlingMutex.Lock()
+		crawling = false
+		crawlingMutex.Unlock()
+	}()
+
+	logrus.Info("Crawling universe...")
+	crawlStart := time.Now()
+	ctx := context.Background()
+
+	resetCrawlMetrics()
+	metrics.Add(metricCrawlRunning, 1)
+	defer metrics.Add(metricCrawlRunning, -1)
+
+	regionIDs, err := getRegionIDs(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Could not get regions.")
+		return
+	}
+
+	ids := toInt64s(regionIDs)
+	levels := []struct {
+		category string
+		resolve  func(context.Context, int64) (CachedLocation, error)
+	}{
+		{"region", refreshRegion},
+		{"constellation", getCachedLocation},
+		{"solar_system", getCachedLocation},
+		{"station", getCachedLocation},
+	}
+
+	for _, level := range levels {
+		logrus.Debugf("Crawling %d locations of category %s", len(ids), level.category)
+		ids = crawlLevel(ctx, ids, level.category, level.resolve, concurrency)
+	}
+
+	metrics.Add(metricCrawlsCompleted, 1)
+	logrus.WithField("time", time.Since(crawlStart)).Info("Done crawling universe.")
+}
+
+// Resolve all locations of a level on the worker pool with at most concurrency jobs at a time, returns the IDs of the
+// resolved locations' children.
+func crawlLevel(ctx context.Context, ids []int64, category string, resolve func(context.Context, int64) (CachedLocation, error), concurrency int) []int64 {
+	var children []int64
+	var childrenMutex sync.Mutex
+
+	metrics.Add(metricCrawlDiscovered, int64(len(ids)))
+
+	jobs := make(chan int64)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for id := range jobs {
+				id := id
+				done := make(chan struct{})
+
+				submitted := workers.submit(ctx, bulkPriority, func() {
+					defer close(done)
+
+					// Category is known from the parent, no need to ask ESI
+					setLocationCategory(id, category)
+
+					location, err := resolve(ctx, id)
+					if err != nil {
+						logrus.WithError(err).Warnf("Could not crawl location %d", id)
+						metrics.Add(metricCrawlFailed, 1)
+						return
+					}
+
+					metrics.Add(metricCrawlResolved, 1)
+
+					childrenMutex.Lock()
+					children = append(children, location.Children...)
+					childrenMutex.Unlock()
+				})
+				if !submitted {
+					continue
+				}
+
+				<-done
+			}
+		}()
+	}
+
+	for _, id := range ids {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	return children
+}
+
+// Refresh a region in cache, regions are only refreshed by the crawler. The request is only transferred again if the
+// region changed.
+func refreshRegion(ctx context.Context, id int64) (CachedLocation, error) {
+	cachedRegion, _, err := fetchLocationFromCache(id)
+	if err != nil {
+		logrus.WithError(err).Warn("Could not get cached region.")
+	}
+
+	region, response, err := esiClient.ESI.UniverseApi.GetUniverseRegionsRegionId(conditionalContext(ctx, cachedRegion), int32(id), nil)
+	var cachedLocation CachedLocation
+	if httpcache.NotModified(response) {
+		cachedLocation = extendExpiry(cachedRegion, response, defaultTTL)
+	} else if err != nil {
+		return CachedLocation{}, err
+	} else {
+		location := pb.Location{
+			Region: &pb.Region{
+				Id:          int64(region.RegionId),
+				Name:        region.Name,
+				Description: region.Description,
+			},
+		}
+
+		cachedLocation = newCachedLocation(int64(region.RegionId), location, response, defaultTTL)
+		cachedLocation.Children = toInt64s(region.Constellations)
+	}
+
+	err = putIntoCache(cachedLocation)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	return cachedLocation, nil
+}
+
+// Refresh all regions in cache, used instead of the crawler if it is disabled. Regions are only transferred again if
+// they changed.
+func updateRegions() {
+	logrus.Debug("Refreshing regions...")
+	ctx := context.Background()
+
+	regionIDs, err := getRegionIDs(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Could not get regions.")
+		return
+	}
+
+	for _, id := range regionIDs {
+		_, err := refreshRegion(ctx, int64(id))
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not refresh region %d", id)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package locations
+
+import (
+	"context"
+	"net"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/pkg/errors"
+)
+
+// LocationError is returned if a location could not be resolved, it carries a machine-readable reason.
+type LocationError struct {
+	ID     int64
+	Reason pb.LocationFailure_Reason
+	Err    error
+}
+
+func (err *LocationError) Error() string {
+	return err.Err.Error()
+}
+
+// Cause returns the underlying error, this makes LocationError compatible with errors.Cause.
+func (err *LocationError) Cause() error {
+	return err.Err
+}
+
+// Failure converts the error into its gRPC representation.
+func (err *LocationError) Failure() *pb.LocationFailure {
+	return &pb.LocationFailure{
+		Reason:  err.Reason,
+		Message: err.Error(),
+	}
+}
+
+// Wrap an error returned while fetching location from a backend, keeps errors which were already classified.
+func upstreamError(id int64, err error) error {
+	if locationErr, ok := err.(*LocationError); ok {
+		return locationErr
+	}
+
+	reason := pb.LocationFailure_UPSTREAM_ERROR
+	if isTimeout(err) {
+		reason = pb.LocationFailure_UPSTREAM_TIMEOUT
+	}
+
+	return &LocationError{ID: id, Reason: reason, Err: err}
+}
+
+// Convert any error into a LocationError for the given ID, unclassified errors get an unknown reason.
+func toLocationError(id int64, err error) *LocationError {
+	if locationErr, ok := err.(*LocationError); ok {
+		if locationErr.ID == id {
+			return locationErr
+		}
+
+		return &LocationError{ID: id, Reason: locationErr.Reason, Err: locationErr.Err}
+	}
+
+	return &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN, Err: err}
+}
+
+// Check whether an error was caused by a timeout
+func isTimeout(err error) bool {
+	cause := errors.Cause(err)
+	if cause == context.DeadlineExceeded {
+		return true
+	}
+
+	netErr, ok := cause.(net.Error)
+	return ok && netErr.Timeout()
+}
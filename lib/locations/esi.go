@@ -0,0 +1,381 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Expiry of locations if ESI does not send an Expires header
+const defaultTTL = 24 * time.Hour
+
+// Conquerable stations change more often
+const conquerableStationTTL = time.Hour
+
+// Fetches a location from ESI.
+func fetchLocationFromESI(ctx context.Context, id int64) (CachedLocation, error) {
+	logrus.Debugf("Getting location %d from ESI", id)
+
+	// Check location type
+	category, err := getLocationCategory(ctx, id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Get and return location
+	switch category {
+	case "station":
+		return fetchStation(ctx, id)
+	case "solar_system":
+		return fetchSolarSystem(ctx, id)
+	case "constellation":
+		return fetchConstellation(ctx, id)
+	case "region":
+		return fetchRegion(ctx, id)
+	default:
+		msg := fmt.Sprintf("Unhandled category '%s'!", category)
+		return CachedLocation{}, &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN, Err: errors.New(msg)}
+	}
+}
+
+// Fetch a station from ESI, concurrent fetches of the same ID are coalesced.
+func fetchStation(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, loadStation)
+}
+
+// Load a station from cache or ESI, use fetchStation instead of calling this.
+func loadStation(ctx context.Context, id int64) (CachedLocation, error) {
+	// Check if recent version is available in cache
+	cachedStation, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Return cached version
+	if !needsUpdate {
+		return cachedStation, nil
+	}
+
+	logrus.WithField("station_id", id).Debug("Loading station from ESI.")
+
+	// Fetch from ESI if not in cache or modified
+	station, response, err := esiClient.ESI.UniverseApi.GetUniverseStationsStationId(conditionalContext(ctx, cachedStation), int32(id), nil)
+	if httpcache.NotModified(response) {
+		return extendExpiry(cachedStation, response, sourceTTL(id)), nil
+	}
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Get solar system
+	solarSystem, err := fetchSolarSystem(ctx, int64(station.SystemId))
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	coordinates := pb.Coordinates{
+		X: float64(station.Position.X),
+		Y: float64(station.Position.Y),
+		Z: float64(station.Position.Z),
+	}
+
+	// Stations without owner are rare, but exist. The owner's name is filled on the next refresh if it can't be
+	// resolved right now.
+	var ownerName string
+	if station.Owner != 0 {
+		ownerName, err = getCorporationName(ctx, int64(station.Owner), sourceTTL(id))
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not resolve owner of station %d", id)
+		}
+	}
+
+	location := pb.Location(solarSystem.Location)
+	location.Station = &pb.Station{
+		Id:                       int64(station.StationId),
+		Name:                     station.Name,
+		TypeId:                   int64(station.TypeId),
+		Public:                   true,
+		Coordinates:              &coordinates,
+		OwnerId:                  int64(station.Owner),
+		OwnerName:                ownerName,
+		RaceId:                   int64(station.RaceId),
+		Services:                 station.Services,
+		ReprocessingEfficiency:   float64(station.ReprocessingEfficiency),
+		ReprocessingStationsTake: float64(station.ReprocessingStationsTake),
+		MaxDockableShipVolume:    float64(station.MaxDockableShipVolume),
+		OfficeRentalCost:         float64(station.OfficeRentalCost),
+		Status:                   pb.Station_LIVE,
+	}
+
+	return newCachedLocation(id, location, response, sourceTTL(id)), nil
+}
+
+// Fetch a solar system from ESI, concurrent fetches of the same ID are coalesced.
+func fetchSolarSystem(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, loadSolarSystem)
+}
+
+// Load a solar system from cache or ESI, use fetchSolarSystem instead of calling this.
+func loadSolarSystem(ctx context.Context, id int64) (CachedLocation, error) {
+	// Check if recent version is available in cache
+	cachedSolarSystem, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Return cached version
+	if !needsUpdate {
+		return cachedSolarSystem, nil
+	}
+
+	logrus.WithField("solar_system_id", id).Debug("Loading solar system from ESI.")
+
+	// Fetch from ESI if not in cache or modified
+	solarSystem, response, err := esiClient.ESI.UniverseApi.GetUniverseSystemsSystemId(conditionalContext(ctx, cachedSolarSystem), int32(id), nil)
+	if httpcache.NotModified(response) {
+		return withSystemFaction(ctx, extendExpiry(cachedSolarSystem, response, sourceTTL(id))), nil
+	}
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Get constellation
+	constellation, err := fetchConstellation(ctx, int64(solarSystem.ConstellationId))
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	location := pb.Location(constellation.Location)
+	wormholeClass := wormholeClassOf(id, int64(solarSystem.ConstellationId), location.Region.GetId())
+	location.SolarSystem = &pb.SolarSystem{
+		Id:             int64(solarSystem.SystemId),
+		Name:           solarSystem.Name,
+		SecurityStatus: float64(solarSystem.SecurityStatus),
+		Coordinates: &pb.Coordinates{
+			X: float64(solarSystem.Position.X),
+			Y: float64(solarSystem.Position.Y),
+			Z: float64(solarSystem.Position.Z),
+		},
+		StarId:         int64(solarSystem.StarId),
+		SecurityClass:  solarSystem.SecurityClass,
+		WormholeClass:  wormholeClass,
+		WormholeEffect: wormholeEffectOf(id, wormholeClass),
+	}
+
+	for _, planet := range solarSystem.Planets {
+		location.SolarSystem.PlanetIds = append(location.SolarSystem.PlanetIds, int64(planet.PlanetId))
+	}
+	location.SolarSystem.StargateIds = toInt64s(solarSystem.Stargates)
+
+	cachedLocation := newCachedLocation(id, location, response, sourceTTL(id))
+	cachedLocation.Children = toInt64s(solarSystem.Stations)
+
+	return withSystemFaction(ctx, cachedLocation), nil
+}
+
+// Fetch a constellation from ESI, concurrent fetches of the same ID are coalesced.
+func fetchConstellation(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, loadConstellation)
+}
+
+// Load a constellation from cache or ESI, use fetchConstellation instead of calling this.
+func loadConstellation(ctx context.Context, id int64) (CachedLocation, error) {
+	// Check if recent version is available in cache
+	cachedConstellation, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Return cached version
+	if !needsUpdate {
+		return cachedConstellation, nil
+	}
+
+	logrus.WithField("constellation_id", id).Debug("Loading constellation from ESI.")
+
+	// Fetch from ESI if not in cache or modified
+	constellation, response, err := esiClient.ESI.UniverseApi.GetUniverseConstellationsConstellationId(conditionalContext(ctx, cachedConstellation), int32(id), nil)
+	if httpcache.NotModified(response) {
+		return extendExpiry(cachedConstellation, response, sourceTTL(id)), nil
+	}
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Get region
+	region, err := fetchRegion(ctx, int64(constellation.RegionId))
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	location := pb.Location(region.Location)
+	location.Constellation = &pb.Constellation{
+		Id:   int64(constellation.ConstellationId),
+		Name: constellation.Name,
+		Coordinates: &pb.Coordinates{
+			X: float64(constellation.Position.X),
+			Y: float64(constellation.Position.Y),
+			Z: float64(constellation.Position.Z),
+		},
+	}
+	location.Constellation.FactionId, _ = sdeFactionOf(location.Constellation.Id, location.Region.GetId())
+
+	cachedLocation := newCachedLocation(id, location, response, sourceTTL(id))
+	cachedLocation.Children = toInt64s(constellation.Systems)
+
+	return cachedLocation, nil
+}
+
+// Fetch a region from ESI, concurrent fetches of the same ID are coalesced.
+func fetchRegion(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, loadRegion)
+}
+
+// Load a region from cache or ESI, use fetchRegion instead of calling this.
+func loadRegion(ctx context.Context, id int64) (CachedLocation, error) {
+	// Check if recent version is available in cache
+	cachedRegion, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// Return cached version
+	if !needsUpdate {
+		return cachedRegion, nil
+	}
+
+	logrus.WithField("region_id", id).Debug("Loading region from ESI.")
+
+	// Fetch from ESI if not in cache or modified
+	region, response, err := esiClient.ESI.UniverseApi.GetUniverseRegionsRegionId(conditionalContext(ctx, cachedRegion), int32(id), nil)
+	if httpcache.NotModified(response) {
+		return extendExpiry(cachedRegion, response, sourceTTL(id)), nil
+	}
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	location := pb.Location{
+		Region: &pb.Region{
+			Id:          int64(region.RegionId),
+			Name:        region.Name,
+			Description: region.Description,
+		},
+	}
+	location.Region.FactionId, _ = sdeFactionOf(location.Region.Id)
+
+	cachedLocation := newCachedLocation(id, location, response, sourceTTL(id))
+	cachedLocation.Children = toInt64s(region.Constellations)
+
+	return cachedLocation, nil
+}
+
+// Run a fetch function for a location unless a fetch for the same ID is already in flight, share results.
+func coalesceFetch(ctx context.Context, id int64, fetch func(context.Context, int64) (CachedLocation, error)) (CachedLocation, error) {
+	location, err := esiFetches.do(ctx, id, func(ctx context.Context) (interface{}, error) {
+		return fetch(ctx, id)
+	})
+
+	cachedLocation, _ := location.(CachedLocation)
+	return cachedLocation, err
+}
+
+// Derive a context for requesting a location which was cached before, ESI only sends it again if it was modified.
+// Entries of an outdated schema and stations whose owner's name could not be resolved are always requested again.
+func conditionalContext(ctx context.Context, cachedLocation CachedLocation) context.Context {
+	if cachedLocation.Version < cachedLocationVersion {
+		return ctx
+	}
+
+	if station := cachedLocation.Location.Station; station != nil && station.OwnerId != 0 && station.OwnerName == "" {
+		return ctx
+	}
+
+	return httpcache.WithETag(ctx, cachedLocation.ETag)
+}
+
+// Create a cache entry from an ESI response, expiry and ETag are taken from the response's headers.
+func newCachedLocation(id int64, location pb.Location, response *http.Response, fallbackTTL time.Duration) CachedLocation {
+	return CachedLocation{
+		ID:        id,
+		ExpiresAt: httpcache.ExpiresAt(response, fallbackTTL),
+		ETag:      httpcache.ETag(response),
+		Location:  location,
+		Version:   cachedLocationVersion,
+	}
+}
+
+// Extend the expiry of a cache entry ESI reported as not modified.
+func extendExpiry(cachedLocation CachedLocation, response *http.Response, fallbackTTL time.Duration) CachedLocation {
+	cachedLocation.ExpiresAt = httpcache.ExpiresAt(response, fallbackTTL)
+	if etag := httpcache.ETag(response); etag != "" {
+		cachedLocation.ETag = etag
+	}
+
+	return cachedLocation
+}
+
+// Last list of region IDs returned by ESI
+var lastRegionIDs []int32
+var lastRegionIDsETag string
+var lastRegionIDsMutex sync.Mutex
+
+// Get IDs of all regions, the last list is reused if ESI reports it as not modified.
+func getRegionIDs(ctx context.Context) ([]int32, error) {
+	lastRegionIDsMutex.Lock()
+	defer lastRegionIDsMutex.Unlock()
+
+	ids, response, err := esiClient.ESI.UniverseApi.GetUniverseRegions(httpcache.WithETag(ctx, lastRegionIDsETag), nil)
+	if httpcache.NotModified(response) && lastRegionIDs != nil {
+		return lastRegionIDs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lastRegionIDs = ids
+	lastRegionIDsETag = httpcache.ETag(response)
+
+	return lastRegionIDs, nil
+}
+
+// Last list of solar system IDs returned by ESI
+var lastSystemIDs []int32
+var lastSystemIDsETag string
+var lastSystemIDsMutex sync.Mutex
+
+// Get IDs of all solar systems, the last list is reused if ESI reports it as not modified.
+func getSystemIDs(ctx context.Context) ([]int32, error) {
+	lastSystemIDsMutex.Lock()
+	defer lastSystemIDsMutex.Unlock()
+
+	ids, response, err := esiClient.ESI.UniverseApi.GetUniverseSystems(httpcache.WithETag(ctx, lastSystemIDsETag), nil)
+	if httpcache.NotModified(response) && lastSystemIDs != nil {
+		return lastSystemIDs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lastSystemIDs = ids
+	lastSystemIDsETag = httpcache.ETag(response)
+
+	return lastSystemIDs, nil
+}
+
+// Convert a slice of ESI's 32 bit IDs
+func toInt64s(ids []int32) []int64 {
+	converted := make([]int64, len(ids))
+	for i, id := range ids {
+		converted[i] = int64(id)
+	}
+
+	return converted
+}
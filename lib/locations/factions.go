@@ -0,0 +1,144 @@
+package locations
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	"github.com/sirupsen/logrus"
+)
+
+// Expiry of the sovereignty map if ESI does not send an Expires header
+const sovereigntyMapTTL = time.Hour
+
+// Factions imported from the SDE, indexed by region, constellation or solar system ID. These take precedence over the
+// sovereignty map. Only written on initialization.
+var sdeFactions = make(map[int64]int64)
+
+// Factions of solar systems taken from ESI's sovereignty map, requested again once expired
+var systemFactions map[int64]int64
+var systemFactionsETag string
+var systemFactionsExpireAt int64
+var systemFactionsMutex sync.Mutex
+
+// Get the faction of a location from the SDE, IDs are checked in order so the most specific one should come first
+func sdeFactionOf(ids ...int64) (int64, bool) {
+	for _, id := range ids {
+		if factionID, ok := sdeFactions[id]; ok {
+			return factionID, true
+		}
+	}
+
+	return 0, false
+}
+
+// Get the faction of a solar system, the SDE wins over the sovereignty map
+func systemFactionOf(ctx context.Context, systemID int64, constellationID int64, regionID int64) (int64, error) {
+	if factionID, ok := sdeFactionOf(systemID, constellationID, regionID); ok {
+		return factionID, nil
+	}
+
+	factions, err := getSystemFactions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return factions[systemID], nil
+}
+
+// Get a copy of a cached solar system with its faction updated, the cached one may be shared with concurrent readers.
+// Systems keep their previous faction if it can't be resolved.
+func withSystemFaction(ctx context.Context, cachedLocation CachedLocation) CachedLocation {
+	solarSystem := cachedLocation.Location.SolarSystem
+	factionID, err := systemFactionOf(ctx, solarSystem.Id, cachedLocation.Location.Constellation.GetId(), cachedLocation.Location.Region.GetId())
+	if err != nil {
+		logrus.WithError(err).Warnf("Could not resolve faction of solar system %d", solarSystem.Id)
+		return cachedLocation
+	}
+
+	if factionID == solarSystem.FactionId {
+		return cachedLocation
+	}
+
+	updated := *solarSystem
+	updated.FactionId = factionID
+	cachedLocation.Location.SolarSystem = &updated
+
+	return cachedLocation
+}
+
+// Get the factions of all solar systems held by one, the last map is reused until it expires or if ESI reports it as
+// not modified.
+func getSystemFactions(ctx context.Context) (map[int64]int64, error) {
+	systemFactionsMutex.Lock()
+	defer systemFactionsMutex.Unlock()
+
+	if systemFactions != nil && time.Now().Unix() < systemFactionsExpireAt {
+		return systemFactions, nil
+	}
+
+	sovereignty, response, err := esiClient.ESI.SovereigntyApi.GetSovereigntyMap(httpcache.WithETag(ctx, systemFactionsETag), nil)
+	if httpcache.NotModified(response) && systemFactions != nil {
+		systemFactionsExpireAt = httpcache.ExpiresAt(response, sovereigntyMapTTL)
+		return systemFactions, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	factions := make(map[int64]int64)
+	for _, system := range sovereignty {
+		if system.FactionId != 0 {
+			factions[int64(system.SystemId)] = int64(system.FactionId)
+		}
+	}
+
+	systemFactions = factions
+	systemFactionsETag = httpcache.ETag(response)
+	systemFactionsExpireAt = httpcache.ExpiresAt(response, sovereigntyMapTTL)
+
+	return systemFactions, nil
+}
+
+// Import factions from an SDE CSV export (mapRegions.csv, mapConstellations.csv and mapSolarSystems.csv)
+func importFactionSDE(path string) error {
+	files := []struct {
+		name     string
+		idColumn string
+	}{
+		{"mapRegions.csv", "regionID"},
+		{"mapConstellations.csv", "constellationID"},
+		{"mapSolarSystems.csv", "solarSystemID"},
+	}
+
+	for _, file := range files {
+		err := readCSV(filepath.Join(path, file.name), func(row map[string]string) error {
+			// Locations without faction are exported as empty or None
+			if row["factionID"] == "" || row["factionID"] == "None" {
+				return nil
+			}
+
+			factionID, err := strconv.ParseInt(row["factionID"], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseInt(row[file.idColumn], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sdeFactions[id] = factionID
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("Imported %d factions from SDE.", len(sdeFactions))
+	return nil
+}
@@ -0,0 +1,285 @@
+package locations
+
+import (
+	"strconv"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// IDs of the structures contained in the last structure feed, used for detecting removed structures
+const feedStructuresBucket = "feedStructures"
+
+// Unchanged structures expiring within this margin are written anyway, so they never expire between two refreshes.
+// This also updates their last seen timestamp, which is not written on every refresh.
+const structureRenewalMargin = time.Hour
+
+// Outcome of applying a structure feed to the cache
+type ingestStats struct {
+	added     int
+	changed   int
+	unchanged int
+	removed   int
+}
+
+// Apply the structure feed to the cache in a single transaction, either all changes are applied or none. Only new and
+// changed structures are written, previously known structures missing in the feed are marked as removed. Overrides take
+// precedence over the feed. Changes are recorded in the structures' history. Systems must contain the solar systems
+// of all structures and overrides.
+func ingestStructures(structures AllStructures, systems map[int64]*pb.Location, now time.Time) (ingestStats, error) {
+	var stats ingestStats
+	var written []CachedLocation
+
+	expireAt := now.Add(structureFeedSource{}.TTL(0)).Unix()
+	renewBefore := now.Add(structureRenewalMargin).Unix()
+
+	removedAt, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return stats, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		feed := tx.Bucket([]byte(feedStructuresBucket))
+		if feed == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		overrides, err := readOverrides(tx)
+		if err != nil {
+			return err
+		}
+
+		// Write a structure unless it is unchanged
+		ingest := func(stored CachedLocation, incoming CachedLocation) error {
+			changed := true
+			switch {
+			case stored.ID == 0:
+				stats.added++
+			case stored.Version == cachedLocationVersion && !structureChanged(&stored.Location, &incoming.Location):
+				stats.unchanged++
+				changed = false
+				if stored.ExpiresAt > renewBefore {
+					return nil
+				}
+			default:
+				stats.changed++
+			}
+
+			if changed {
+				err := recordStructureSnapshot(tx, stored, incoming, now)
+				if err != nil {
+					return err
+				}
+			}
+
+			err := storeLocation(tx, incoming)
+			if err != nil {
+				return err
+			}
+
+			written = append(written, incoming)
+			return nil
+		}
+
+		for key, structure := range structures {
+			id, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				logrus.WithError(err).Warnf("Failed to parse structure ID %s", key)
+				continue
+			}
+
+			system, ok := systems[structure.SystemID]
+			if !ok {
+				return errors.Errorf("solar system %d of structure %d is unknown", structure.SystemID, id)
+			}
+
+			incoming, err := newStructureLocation(id, structure, system, expireAt)
+			if err != nil {
+				logrus.WithError(err).Warnf("Failed to convert structure %d", id)
+				continue
+			}
+
+			if override, ok := overrides[id]; ok {
+				incoming = applyOverride(incoming, override, systems[override.SolarSystemId])
+			}
+
+			if feed.Get([]byte(key)) == nil {
+				err = feed.Put([]byte(key), []byte{})
+				if err != nil {
+					return err
+				}
+			}
+
+			stored, err := readLocation(tx, id)
+			if err != nil {
+				return err
+			}
+
+			err = ingest(stored, incoming)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Structures missing in the feed which are kept alive or suppressed by their override
+		overridden := make(map[int64]struct{})
+		for id, override := range overrides {
+			if _, ok := structures[strconv.FormatInt(id, 10)]; ok {
+				continue
+			}
+
+			// Partial overrides don't keep structures alive
+			if !override.Suppressed && (override.Name == "" || override.SolarSystemId == 0) {
+				continue
+			}
+
+			stored, err := readLocation(tx, id)
+			if err != nil {
+				return err
+			}
+
+			incoming, ok := overrideUnfedStructure(stored, override, systems[override.SolarSystemId], expireAt)
+			if !ok {
+				continue
+			}
+			overridden[id] = struct{}{}
+
+			err = ingest(stored, incoming)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Find structures which were part of the last feed, but are missing now
+		var missingKeys [][]byte
+		err = feed.ForEach(func(key []byte, value []byte) error {
+			if _, ok := structures[string(key)]; !ok {
+				missingKeys = append(missingKeys, key)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range missingKeys {
+			id, parseErr := strconv.ParseInt(string(key), 10, 64)
+
+			err = feed.Delete(key)
+			if err != nil {
+				return err
+			}
+
+			if parseErr != nil {
+				logrus.WithError(parseErr).Warnf("Failed to parse structure ID %s", key)
+				continue
+			}
+
+			if _, ok := overridden[id]; ok {
+				continue
+			}
+
+			stored, err := readLocation(tx, id)
+			if err != nil {
+				return err
+			}
+
+			if stored.ID == 0 || stored.Location.Station == nil || isHidden(&stored.Location) {
+				continue
+			}
+
+			removed := markRemoved(stored, removedAt)
+			err = storeLocation(tx, removed)
+			if err != nil {
+				return err
+			}
+
+			err = recordStructureSnapshot(tx, stored, removed, now)
+			if err != nil {
+				return err
+			}
+			written = append(written, removed)
+			stats.removed++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ingestStats{}, err
+	}
+
+	for _, cachedLocation := range written {
+		addToMemoryCaches(cachedLocation)
+	}
+
+	return stats, nil
+}
+
+// Check whether the attributes of a structure clients care about changed. The feed advances the last seen timestamp of
+// active structures on every refresh, it is only written when unchanged structures are renewed.
+func structureChanged(stored *pb.Location, incoming *pb.Location) bool {
+	storedStation := stored.Station
+	incomingStation := incoming.Station
+	if storedStation == nil || incomingStation == nil {
+		return storedStation != incomingStation
+	}
+
+	return storedStation.Name != incomingStation.Name ||
+		storedStation.TypeId != incomingStation.TypeId ||
+		storedStation.TypeName != incomingStation.TypeName ||
+		storedStation.Public != incomingStation.Public ||
+		storedStation.Status != incomingStation.Status ||
+		stored.SolarSystem.GetId() != incoming.SolarSystem.GetId() ||
+		!proto.Equal(storedStation.Coordinates, incomingStation.Coordinates)
+}
+
+// Get a copy of a cached structure marked as removed, the cached one may be shared with concurrent readers
+func markRemoved(cachedLocation CachedLocation, removedAt *timestamp.Timestamp) CachedLocation {
+	station := *cachedLocation.Location.Station
+	station.Status = pb.Station_REMOVED
+	station.RemovedAt = removedAt
+	cachedLocation.Location.Station = &station
+
+	return cachedLocation
+}
+
+// Check whether a location is a structure which disappeared from the feed or was suppressed, these are hidden from
+// listings by default
+func isHidden(location *pb.Location) bool {
+	if location.Station == nil {
+		return false
+	}
+
+	return location.Station.Status == pb.Station_REMOVED || location.Station.Status == pb.Station_SUPPRESSED
+}
+
+// Populate the set of structures known from the feed with the cached ones, used for populating the newly created bucket.
+// Structures resolved via ESI carry an ETag and are not part of the feed.
+func seedFeedStructures(tx *bolt.Tx) error {
+	bucket := tx.Bucket([]byte(feedStructuresBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	return tx.Bucket([]byte("locations")).ForEach(func(key []byte, value []byte) error {
+		id, err := strconv.ParseInt(string(key), 10, 64)
+		if err != nil || !(structureFeedSource{}).Claims(id) {
+			return nil
+		}
+
+		var cachedLocation CachedLocation
+		err = cachedLocation.UnmarshalJSON(value)
+		if err != nil || cachedLocation.ETag != "" {
+			return nil
+		}
+
+		return bucket.Put(key, []byte{})
+	})
+}
@@ -0,0 +1,164 @@
+package locations
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+)
+
+const (
+	testOverriddenStructureID = 1022734985680
+	testChangingStructureID   = 1022734985681
+	testRemovedStructureID    = 1022734985682
+	testUnfedStructureID      = 1022734985683
+)
+
+func testFeedStructure(name string) Structure {
+	return Structure{
+		TypeID:      35833,
+		Name:        name,
+		SystemID:    testSolarSystemID,
+		TypeName:    "Fortizar",
+		Public:      true,
+		Coordinates: pb.Coordinates{X: 1, Y: 2, Z: 3},
+		FirstSeen:   time.Unix(1500000000, 0),
+		LastSeen:    time.Unix(1500000000, 0),
+	}
+}
+
+// Expected name and status of a structure after a run
+type feedStructureState struct {
+	name   string
+	status pb.Station_Status
+}
+
+func TestIngestStructures(t *testing.T) {
+	_, cleanup := setupTestCache(t, http.NotFoundHandler())
+	defer cleanup()
+
+	systems := map[int64]*pb.Location{
+		testSolarSystemID: {
+			Region:        &pb.Region{Id: 10000002, Name: "The Forge"},
+			Constellation: &pb.Constellation{Id: 20000020, Name: "Kimotoro"},
+			SolarSystem:   &pb.SolarSystem{Id: testSolarSystemID, Name: "Jita"},
+		},
+	}
+
+	// One override renames a fed structure, the other keeps a structure alive which is not part of the feed
+	err := db.Update(func(tx *bolt.Tx) error {
+		err := putOverride(tx, StoredOverride{Override: pb.StructureOverride{
+			StructureId: testOverriddenStructureID,
+			Name:        "Overridden Fortizar",
+		}})
+		if err != nil {
+			return err
+		}
+
+		return putOverride(tx, StoredOverride{Override: pb.StructureOverride{
+			StructureId:   testUnfedStructureID,
+			Name:          "Unfed Fortizar",
+			SolarSystemId: testSolarSystemID,
+		}})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Runs are applied in order, each one builds on the cache left by the previous
+	runs := []struct {
+		name       string
+		structures AllStructures
+		stats      ingestStats
+		states     map[int64]feedStructureState
+	}{
+		{
+			"initial feed",
+			AllStructures{
+				"1022734985680": testFeedStructure("Fed Fortizar"),
+				"1022734985681": testFeedStructure("Old Fortizar"),
+				"1022734985682": testFeedStructure("Doomed Fortizar"),
+			},
+			ingestStats{added: 4},
+			map[int64]feedStructureState{
+				testOverriddenStructureID: {"Overridden Fortizar", pb.Station_LIVE},
+				testChangingStructureID:   {"Old Fortizar", pb.Station_LIVE},
+				testRemovedStructureID:    {"Doomed Fortizar", pb.Station_LIVE},
+				testUnfedStructureID:      {"Unfed Fortizar", pb.Station_LIVE},
+			},
+		},
+		{
+			"renamed and missing structures",
+			AllStructures{
+				"1022734985680": testFeedStructure("Renamed Fed Fortizar"),
+				"1022734985681": testFeedStructure("New Fortizar"),
+			},
+			ingestStats{changed: 1, unchanged: 2, removed: 1},
+			map[int64]feedStructureState{
+				testOverriddenStructureID: {"Overridden Fortizar", pb.Station_LIVE},
+				testChangingStructureID:   {"New Fortizar", pb.Station_LIVE},
+				testRemovedStructureID:    {"Doomed Fortizar", pb.Station_REMOVED},
+				testUnfedStructureID:      {"Unfed Fortizar", pb.Station_LIVE},
+			},
+		},
+		{
+			"removed structure reappears",
+			AllStructures{
+				"1022734985680": testFeedStructure("Renamed Fed Fortizar"),
+				"1022734985681": testFeedStructure("New Fortizar"),
+				"1022734985682": testFeedStructure("Doomed Fortizar"),
+			},
+			ingestStats{changed: 1, unchanged: 3},
+			map[int64]feedStructureState{
+				testOverriddenStructureID: {"Overridden Fortizar", pb.Station_LIVE},
+				testChangingStructureID:   {"New Fortizar", pb.Station_LIVE},
+				testRemovedStructureID:    {"Doomed Fortizar", pb.Station_LIVE},
+				testUnfedStructureID:      {"Unfed Fortizar", pb.Station_LIVE},
+			},
+		},
+	}
+
+	now := time.Now()
+	for _, run := range runs {
+		now = now.Add(time.Minute)
+
+		stats, err := ingestStructures(run.structures, systems, now)
+		if err != nil {
+			t.Fatalf("%s: %v", run.name, err)
+		}
+
+		if stats != run.stats {
+			t.Errorf("%s: expected stats %+v, got %+v", run.name, run.stats, stats)
+		}
+
+		for id, state := range run.states {
+			var stored CachedLocation
+			err := db.View(func(tx *bolt.Tx) error {
+				var err error
+				stored, err = readLocation(tx, id)
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			station := stored.Location.Station
+			if station == nil {
+				t.Errorf("%s: expected structure %d to be stored", run.name, id)
+				continue
+			}
+
+			if station.Name != state.name || station.Status != state.status {
+				t.Errorf("%s: expected structure %d to be %q %s, got %q %s", run.name, id, state.name, state.status,
+					station.Name, station.Status)
+			}
+
+			if stored.Location.SolarSystem.GetId() != testSolarSystemID {
+				t.Errorf("%s: expected structure %d to be placed in its solar system, got %v", run.name, id,
+					stored.Location.SolarSystem)
+			}
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package locations
+
+import (
+	"context"
+	"strconv"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Secondary indexes of the locations bucket. Both contain a nested bucket per parent, which maps its children's IDs
+// to their kind.
+const childrenBucket = "children"
+const regionStationsBucket = "regionStations"
+
+// ListChildren returns the cached direct children of a region, constellation or solar system
+func ListChildren(context context.Context, request *pb.ListChildrenRequest) (*pb.ListLocationsResponse, error) {
+	kinds := make(map[pb.LocationKind]struct{})
+	for _, kind := range request.GetKinds() {
+		kinds[kind] = struct{}{}
+	}
+
+	wormholeClasses := make(map[pb.SolarSystem_WormholeClass]struct{})
+	for _, class := range request.GetWormholeClasses() {
+		wormholeClasses[class] = struct{}{}
+	}
+
+	wormholeEffects := make(map[pb.SolarSystem_WormholeEffect]struct{})
+	for _, effect := range request.GetWormholeEffects() {
+		wormholeEffects[effect] = struct{}{}
+	}
+
+	return listIndexedLocations(childrenBucket, request.GetParentId(), request.GetIncludeRemoved(), func(kind pb.LocationKind, location *pb.Location) bool {
+		if _, ok := kinds[kind]; len(kinds) > 0 && !ok {
+			return false
+		}
+
+		if len(wormholeClasses) == 0 && len(wormholeEffects) == 0 {
+			return true
+		}
+
+		if location.SolarSystem == nil {
+			return false
+		}
+
+		if _, ok := wormholeClasses[location.SolarSystem.WormholeClass]; len(wormholeClasses) > 0 && !ok {
+			return false
+		}
+
+		_, ok := wormholeEffects[location.SolarSystem.WormholeEffect]
+		return len(wormholeEffects) == 0 || ok
+	})
+}
+
+// ListStationsInRegion returns all cached stations and structures in a region
+func ListStationsInRegion(context context.Context, request *pb.ListStationsInRegionRequest) (*pb.ListLocationsResponse, error) {
+	return listIndexedLocations(regionStationsBucket, request.GetRegionId(), request.GetIncludeRemoved(), func(kind pb.LocationKind, location *pb.Location) bool {
+		return !request.GetPublicOnly() || location.Station.Public
+	})
+}
+
+// Get all cached locations listed under a parent in an index which pass the filter, hidden structures are skipped
+// unless includeHidden is set
+func listIndexedLocations(bucketName string, parentID int64, includeHidden bool, filter func(pb.LocationKind, *pb.Location) bool) (*pb.ListLocationsResponse, error) {
+	if parentID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Parent ID must be set")
+	}
+
+	var ids []int64
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(bucketName))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		parent := bucket.Bucket([]byte(strconv.FormatInt(parentID, 10)))
+		if parent == nil {
+			return nil
+		}
+
+		return parent.ForEach(func(key []byte, value []byte) error {
+			id, err := strconv.ParseInt(string(key), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, id)
+			return nil
+		})
+	})
+	if err != nil {
+		logrus.WithError(err).Error("could not read location index")
+		return nil, status.Error(codes.Internal, "Error reading index")
+	}
+
+	response := pb.ListLocationsResponse{
+		Locations: make(map[int64]*pb.Location),
+	}
+
+	for _, id := range ids {
+		cachedLocation, _, err := fetchLocationFromCache(id)
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not get indexed location %d from cache", id)
+			continue
+		}
+		if cachedLocation.ID == 0 {
+			continue
+		}
+
+		// Skip entries left behind by locations which moved, e.g. entries stored by older versions
+		if indexParents(&cachedLocation.Location)[bucketName] != parentID {
+			continue
+		}
+
+		if !includeHidden && isHidden(&cachedLocation.Location) {
+			continue
+		}
+
+		location := cachedLocation.Location
+		kind, _ := describeLocation(&location)
+		if filter(kind, &location) {
+			response.Locations[id] = &location
+		}
+	}
+
+	return &response, nil
+}
+
+// Add a location to the secondary indexes and remove it from the parents it was listed under before if it moved (e.g.
+// a structure moved to another solar system), must be called within a writable transaction. previous is the location
+// as it was stored before, nil if it is unknown.
+func indexLocation(tx *bolt.Tx, previous *pb.Location, cachedLocation CachedLocation) error {
+	location := &cachedLocation.Location
+	kind, _ := describeLocation(location)
+	key := []byte(strconv.FormatInt(cachedLocation.ID, 10))
+	value := []byte(strconv.Itoa(int(kind)))
+
+	parents := indexParents(location)
+
+	if previous != nil {
+		for bucketName, previousParentID := range indexParents(previous) {
+			if parents[bucketName] == previousParentID {
+				continue
+			}
+
+			err := deleteFromIndex(tx, bucketName, previousParentID, key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for bucketName, parentID := range parents {
+		err := putIntoIndex(tx, bucketName, parentID, key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the parent a location is listed under in each index, indexes it is not listed in are omitted
+func indexParents(location *pb.Location) map[string]int64 {
+	parents := make(map[string]int64)
+	kind, _ := describeLocation(location)
+
+	parentID := parentOf(kind, location)
+	if parentID != 0 {
+		parents[childrenBucket] = parentID
+	}
+
+	if (kind == pb.LocationKind_STATION || kind == pb.LocationKind_STRUCTURE) && location.Region != nil {
+		parents[regionStationsBucket] = location.Region.Id
+	}
+
+	return parents
+}
+
+// Add a key to a parent's nested bucket in an index
+func putIntoIndex(tx *bolt.Tx, bucketName string, parentID int64, key []byte, value []byte) error {
+	bucket := tx.Bucket([]byte(bucketName))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	parent, err := bucket.CreateBucketIfNotExists([]byte(strconv.FormatInt(parentID, 10)))
+	if err != nil {
+		return err
+	}
+
+	return parent.Put(key, value)
+}
+
+// Remove a key from a parent's nested bucket in an index
+func deleteFromIndex(tx *bolt.Tx, bucketName string, parentID int64, key []byte) error {
+	bucket := tx.Bucket([]byte(bucketName))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	parent := bucket.Bucket([]byte(strconv.FormatInt(parentID, 10)))
+	if parent == nil {
+		return nil
+	}
+
+	return parent.Delete(key)
+}
+
+// Index all locations already in cache, used for populating newly created indexes
+func rebuildIndexes(tx *bolt.Tx) error {
+	bucket := tx.Bucket([]byte("locations"))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	return bucket.ForEach(func(key []byte, value []byte) error {
+		var cachedLocation CachedLocation
+		err := cachedLocation.UnmarshalJSON(value)
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not index location %s", key)
+			return nil
+		}
+
+		return indexLocation(tx, nil, cachedLocation)
+	})
+}
+
+// Get the ID of a location's direct parent, zero for regions
+func parentOf(kind pb.LocationKind, location *pb.Location) int64 {
+	switch kind {
+	case pb.LocationKind_STATION, pb.LocationKind_STRUCTURE, pb.LocationKind_CELESTIAL:
+		if location.SolarSystem != nil {
+			return location.SolarSystem.Id
+		}
+	case pb.LocationKind_SOLAR_SYSTEM:
+		if location.Constellation != nil {
+			return location.Constellation.Id
+		}
+	case pb.LocationKind_CONSTELLATION:
+		if location.Region != nil {
+			return location.Region.Id
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,194 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Contains a nested bucket per structure, which maps zero-padded sequence numbers to snapshots of its attributes
+const structureHistoryBucket = "structureHistory"
+
+// Maximum number of structures whose history can be requested at once
+const maxHistoryIDs = 1000
+
+// GetStructureHistory returns the recorded attribute changes of structures
+func GetStructureHistory(context context.Context, request *pb.GetStructureHistoryRequest) (*pb.GetStructureHistoryResponse, error) {
+	ids := deduplicateIDs(request.GetStructureIds())
+	if len(ids) > maxHistoryIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d structures can be requested at once", maxHistoryIDs)
+	}
+
+	response := pb.GetStructureHistoryResponse{
+		Histories: make(map[int64]*pb.StructureHistory),
+	}
+
+	err := db.View(func(tx *bolt.Tx) error {
+		for _, id := range ids {
+			snapshots, err := readStructureHistory(tx, id)
+			if err != nil {
+				return err
+			}
+
+			if len(snapshots) == 0 {
+				continue
+			}
+
+			history := &pb.StructureHistory{}
+			for _, snapshot := range snapshots {
+				observedAt, err := ptypes.TimestampProto(time.Unix(snapshot.ObservedAt, 0))
+				if err != nil {
+					return err
+				}
+
+				history.Snapshots = append(history.Snapshots, &pb.StructureSnapshot{
+					ObservedAt:    observedAt,
+					Name:          snapshot.Name,
+					TypeId:        snapshot.TypeID,
+					TypeName:      snapshot.TypeName,
+					Public:        snapshot.Public,
+					SolarSystemId: snapshot.SolarSystemID,
+					Status:        snapshot.Status,
+				})
+			}
+
+			response.Histories[id] = history
+		}
+
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Error("could not read structure history")
+		return nil, status.Error(codes.Internal, "Error reading structure history")
+	}
+
+	return &response, nil
+}
+
+// Get all snapshots of a structure, oldest first
+func readStructureHistory(tx *bolt.Tx, id int64) ([]StructureSnapshot, error) {
+	bucket := tx.Bucket([]byte(structureHistoryBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	structure := bucket.Bucket([]byte(strconv.FormatInt(id, 10)))
+	if structure == nil {
+		return nil, nil
+	}
+
+	var snapshots []StructureSnapshot
+	err := structure.ForEach(func(key []byte, value []byte) error {
+		var snapshot StructureSnapshot
+		err := snapshot.UnmarshalJSON(value)
+		if err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+
+	return snapshots, err
+}
+
+// Record a structure's attributes if they differ from the last snapshot, must be called within a writable
+// transaction. If there is no history yet, the previously stored version (if any) is recorded first, observed at the
+// time it was first seen.
+func recordStructureSnapshot(tx *bolt.Tx, previous CachedLocation, current CachedLocation, observedAt time.Time) error {
+	bucket := tx.Bucket([]byte(structureHistoryBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	structure, err := bucket.CreateBucketIfNotExists([]byte(strconv.FormatInt(current.ID, 10)))
+	if err != nil {
+		return err
+	}
+
+	var last StructureSnapshot
+	hasLast := true
+	_, lastValue := structure.Cursor().Last()
+	if lastValue != nil {
+		err = last.UnmarshalJSON(lastValue)
+		if err != nil {
+			return err
+		}
+	} else if previous.Location.Station != nil {
+		previousObservedAt := observedAt
+		if firstSeen, err := ptypes.Timestamp(previous.Location.Station.FirstSeen); err == nil {
+			previousObservedAt = firstSeen
+		}
+
+		last = snapshotOf(previous, previousObservedAt)
+		// Structures cached before statuses were introduced were live
+		if last.Status == pb.Station_UNKNOWN {
+			last.Status = pb.Station_LIVE
+		}
+
+		err = putSnapshot(structure, last)
+		if err != nil {
+			return err
+		}
+	} else {
+		hasLast = false
+	}
+
+	// Only the observation time differs if nothing changed
+	snapshot := snapshotOf(current, observedAt)
+	if hasLast {
+		last.ObservedAt = snapshot.ObservedAt
+		if snapshot == last {
+			return nil
+		}
+	}
+
+	return putSnapshot(structure, snapshot)
+}
+
+// Append a snapshot to a structure's history
+func putSnapshot(structure *bolt.Bucket, snapshot StructureSnapshot) error {
+	snapshotJSON, err := snapshot.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return appendToBucket(structure, snapshotJSON)
+}
+
+// Append a value to a bucket used as log, keys are zero-padded sequence numbers so entries are iterated in order
+func appendToBucket(bucket *bolt.Bucket, value []byte) error {
+	sequence, err := bucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte(fmt.Sprintf("%020d", sequence)), value)
+}
+
+// Get the history-relevant attributes of a cached structure
+func snapshotOf(cachedLocation CachedLocation, observedAt time.Time) StructureSnapshot {
+	station := cachedLocation.Location.Station
+	snapshot := StructureSnapshot{
+		ObservedAt: observedAt.Unix(),
+		Name:       station.Name,
+		TypeID:     station.TypeId,
+		TypeName:   station.TypeName,
+		Public:     station.Public,
+		Status:     station.Status,
+	}
+
+	if cachedLocation.Location.SolarSystem != nil {
+		snapshot.SolarSystemID = cachedLocation.Location.SolarSystem.Id
+	}
+
+	return snapshot
+}
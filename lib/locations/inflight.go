@@ -0,0 +1,79 @@
+package locations
+
+import (
+	"context"
+	"sync"
+)
+
+// inflightGroup coalesces concurrent calls for the same location ID. Only the first caller starts the call, every
+// other caller waits for it and receives the shared result.
+type inflightGroup struct {
+	mutex sync.Mutex
+	calls map[int64]*inflightCall
+}
+
+// inflightCall is a call which is currently executed by an inflightGroup. It runs detached from the callers' contexts
+// and is only cancelled once every caller gave up waiting for it.
+type inflightCall struct {
+	done    chan struct{}
+	value   interface{}
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newInflightGroup() *inflightGroup {
+	return &inflightGroup{calls: make(map[int64]*inflightCall)}
+}
+
+// Execute fn for the given ID unless a call for that ID is already in flight, in that case wait for it to finish and
+// return its result. If ctx is done before the call finishes, ctx's error is returned. No call is started for callers
+// which already gave up.
+func (group *inflightGroup) do(ctx context.Context, id int64, fn func(context.Context) (interface{}, error)) (interface{}, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	group.mutex.Lock()
+	call, ok := group.calls[id]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.Background())
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel}
+		group.calls[id] = call
+
+		go func() {
+			call.value, call.err = fn(callCtx)
+			cancel()
+
+			group.mutex.Lock()
+			group.forget(id, call)
+			group.mutex.Unlock()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	group.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		group.mutex.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Nobody is interested anymore, abort and let the next caller start over
+			call.cancel()
+			group.forget(id, call)
+		}
+		group.mutex.Unlock()
+
+		return nil, ctx.Err()
+	}
+}
+
+// Remove a call from the group unless it was already replaced, the group's mutex must be held
+func (group *inflightGroup) forget(id int64, call *inflightCall) {
+	if group.calls[id] == call {
+		delete(group.calls, id)
+	}
+}
@@ -19,51 +19,249 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// GetLocations returns location info for a given list.
+// GetLocations returns location info for a given list, IDs which could not be resolved are reported as failures.
 func GetLocations(context context.Context, request *pb.GetLocationsRequest) (*pb.GetLocationsResponse, error) {
-	locations, _ := getLocations(request.GetLocationIds())
+	success, failure, ids := resolveLocations(context, request.GetLocationIds(), interactivePriority)
+	response := newLocationsResponse()
 
-	return &pb.GetLocationsResponse{Locations: locations}, nil
+	pending := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		pending[id] = struct{}{}
+	}
+
+	// Stop waiting shortly before the deadline, otherwise the client gives up before receiving the partial response
+	cutoff := newDeadlineCutoff(context)
+	defer cutoff.Stop()
+
+	for len(pending) > 0 {
+		select {
+		case location := <-success:
+			addLocationToResponse(response, location)
+			delete(pending, location.ID)
+		case err := <-failure:
+			addFailureToResponse(response, err)
+			delete(pending, err.ID)
+		case <-cutoff.C:
+			// Return partial result, report locations which could not be resolved in time
+			for id := range pending {
+				msg := fmt.Sprintf("location %d could not be resolved before the request's deadline", id)
+				addFailureToResponse(response, &LocationError{ID: id, Reason: pb.LocationFailure_UPSTREAM_TIMEOUT, Err: errors.New(msg)})
+			}
+
+			return response, nil
+		case <-context.Done():
+			// Request was cancelled, report remaining locations anyway
+			for id := range pending {
+				addFailureToResponse(response, toLocationError(id, upstreamError(id, context.Err())))
+			}
+
+			return response, nil
+		}
+	}
+
+	return response, nil
+}
+
+// Time reserved for sending a partial response before a request's deadline, at most a tenth of the time available
+const deadlineMargin = 500 * time.Millisecond
+
+// Create a timer firing shortly before ctx's deadline, it never fires if ctx has no deadline.
+func newDeadlineCutoff(ctx context.Context) *time.Timer {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		timer := time.NewTimer(time.Hour)
+		timer.Stop()
+		return timer
+	}
+
+	remaining := time.Until(deadline)
+	margin := deadlineMargin
+	if remaining/10 < margin {
+		margin = remaining / 10
+	}
+
+	return time.NewTimer(remaining - margin)
+}
+
+// StreamLocations sends location info for a given list as soon as it is resolved, batching locations which are
+// available at the same time (e.g. cache hits).
+func StreamLocations(request *pb.GetLocationsRequest, stream pb.StaticData_StreamLocationsServer) error {
+	success, failure, ids := resolveLocations(stream.Context(), request.GetLocationIds(), interactivePriority)
+	outstandingRequests := len(ids)
+	batch := newLocationsResponse()
+	batchSize := 0
+
+	for outstandingRequests > 0 {
+		select {
+		case location := <-success:
+			addLocationToResponse(batch, location)
+		case err := <-failure:
+			addFailureToResponse(batch, err)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		outstandingRequests--
+		batchSize++
+
+		// Flush batch if no other results are ready or if it is full
+		if (len(success) == 0 && len(failure) == 0) || batchSize >= streamBatchSize {
+			err := stream.Send(batch)
+			if err != nil {
+				return err
+			}
+
+			batch = newLocationsResponse()
+			batchSize = 0
+		}
+	}
+
+	return nil
 }
 
+// Create an empty response for GetLocations and StreamLocations
+func newLocationsResponse() *pb.GetLocationsResponse {
+	return &pb.GetLocationsResponse{
+		Locations: make(map[int64]*pb.Location),
+		Failures:  make(map[int64]*pb.LocationFailure),
+	}
+}
+
+// Add a resolved location to a response, flag it if it was served from an expired cache entry
+func addLocationToResponse(response *pb.GetLocationsResponse, location CachedLocation) {
+	response.Locations[location.ID] = &location.Location
+	if location.Stale {
+		response.StaleLocationIds = append(response.StaleLocationIds, location.ID)
+	}
+}
+
+// Add a failed location to a response
+func addFailureToResponse(response *pb.GetLocationsResponse, err *LocationError) {
+	logrus.Warn(err.Error())
+	response.Failures[err.ID] = err.Failure()
+}
+
+// Maximum number of results sent in a single message by StreamLocations
+const streamBatchSize = 100
+
 var db *bolt.DB
 var esiClient *goesi.APIClient
 var genericClient *http.Client
 var structureHuntURL string
+var workers *workerPool
+var hotCache *locationLRU
+var staleWhileRevalidate bool
+
+// Registries of in-flight cache updates and ESI fetches, used for fetching each location at most once at a time
+var locationUpdates = newInflightGroup()
+var esiFetches = newInflightGroup()
+
+// Options tune how locations are resolved and cached
+type Options struct {
+	// Maximum number of locations resolved concurrently
+	PoolSize int
+	// Number of locations kept in memory
+	CacheSize int
+	// Serve expired entries right away and refresh them in background
+	StaleWhileRevalidate bool
+	// Interval between crawls of the whole universe, zero disables the crawler
+	CrawlInterval time.Duration
+	// Maximum number of locations resolved concurrently by the crawler
+	CrawlConcurrency int
+	// Directory containing an SDE CSV export used for wormhole classes, effects and factions, optional
+	SDEPath string
+	// SSO credentials for resolving structures missing in the structure feed via ESI, optional
+	StructureAuth *StructureAuth
+}
 
 // Initialize initializes infrastructure for locations
-func Initialize(esi *goesi.APIClient, gen *http.Client, url string, database *bolt.DB) {
+func Initialize(esi *goesi.APIClient, gen *http.Client, url string, database *bolt.DB, options Options) {
 	db = database
 	esiClient = esi
 	genericClient = gen
 	structureHuntURL = url
+	workers = newWorkerPool(options.PoolSize)
+	hotCache = newLocationLRU(options.CacheSize)
+	staleWhileRevalidate = options.StaleWhileRevalidate
+	crawlerEnabled = options.CrawlInterval > 0
 
-	// Initialize buckets
-	err := db.Update(func(tx *bolt.Tx) error {
-		tx.CreateBucketIfNotExists([]byte("locations"))
-		return nil
-	})
+	if options.SDEPath != "" {
+		err := importWormholeSDE(options.SDEPath)
+		if err != nil {
+			logrus.WithError(err).Warn("Could not import wormhole data from SDE, falling back to bundled table")
+		}
+
+		err = importFactionSDE(options.SDEPath)
+		if err != nil {
+			logrus.WithError(err).Warn("Could not import faction data from SDE, falling back to sovereignty map")
+		}
+	}
+
+	if options.StructureAuth != nil {
+		source, err := newESIStructureSource(genericClient, *options.StructureAuth)
+		if err != nil {
+			panic(err)
+		}
+
+		RegisterSource(source)
+	}
+
+	err := db.Update(initializeBuckets)
 	if err != nil {
 		panic(err)
 	}
 
 	// Initialize static data
+	go loadMemoryIndexes()
 	go scheduleStaticDataUpdate()
+	go scheduleCrawl(options.CrawlInterval, options.CrawlConcurrency)
+}
+
+// Initialize buckets, populate indexes if they were just created
+func initializeBuckets(tx *bolt.Tx) error {
+	tx.CreateBucketIfNotExists([]byte("locations"))
+	tx.CreateBucketIfNotExists([]byte(forbiddenStructuresBucket))
+	tx.CreateBucketIfNotExists([]byte(unknownLocationsBucket))
+	tx.CreateBucketIfNotExists([]byte(structureHistoryBucket))
+	tx.CreateBucketIfNotExists([]byte(structureOverridesBucket))
+	tx.CreateBucketIfNotExists([]byte(overrideAuditBucket))
+
+	if tx.Bucket([]byte(feedStructuresBucket)) == nil {
+		tx.CreateBucketIfNotExists([]byte(feedStructuresBucket))
+		err := seedFeedStructures(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if tx.Bucket([]byte(childrenBucket)) != nil {
+		return nil
+	}
+
+	tx.CreateBucketIfNotExists([]byte(childrenBucket))
+	tx.CreateBucketIfNotExists([]byte(regionStationsBucket))
+	return rebuildIndexes(tx)
 }
 
 // Keep ticking in own goroutine and spawn worker tasks.
 func scheduleStaticDataUpdate() {
 	// Load on start...
-	go updateStructures()
-	go updateRegions()
+	refreshSources()
 
 	// ...then update every 30 minutes
 	ticker := time.NewTicker(30 * time.Minute)
 	defer ticker.Stop()
 	for {
 		<-ticker.C
-		go updateStructures()
-		go updateRegions()
+		refreshSources()
+	}
+}
+
+// Refresh all sources in bulk
+func refreshSources() {
+	for _, source := range allSources() {
+		logrus.Debugf("Refreshing source %s", source.Name())
+		go source.Refresh()
 	}
 }
 
@@ -106,6 +304,12 @@ func updateStructures() {
 
 	logrus.Debugf("OK! Got %d structures.", len(allStructures))
 
+	// An empty feed is most likely an error of the 3rd party API, don't remove all structures
+	if len(allStructures) == 0 {
+		logrus.Warn("3rd party structure API returned no structures")
+		return
+	}
+
 	// Take each structure and fetch solar system info, then store in DB
 	systemIDs := make([]int64, len(allStructures))
 	var i int
@@ -114,85 +318,53 @@ func updateStructures() {
 		i++
 	}
 
-	_, err = getLocations(systemIDs)
+	// Overrides may move structures to other solar systems
+	overriddenSystemIDs, err := overrideSystemIDs()
 	if err != nil {
-		logrus.WithError(err).Warnf("Failed to update structure cache")
+		logrus.WithError(err).Warnf("Failed to read structure overrides")
 		return
 	}
+	systemIDs = append(systemIDs, overriddenSystemIDs...)
 
-	// Store structures in cache (expire after 1 day, this has no effect)
-	expireAt := time.Now().Unix() + 86400
-	for key, structure := range allStructures {
-		go storeStructure(key, structure, expireAt)
-	}
-}
-
-// Update all structures in cache
-func updateRegions() {
-	logrus.Debug("Downloading regions...")
-
-	// Fetch IDs from ESI
-	regionIDs, _, err := esiClient.ESI.UniverseApi.GetUniverseRegions(nil, nil)
+	systems, err := getLocations(context.Background(), systemIDs)
 	if err != nil {
-		logrus.WithError(err).Error("Could not get regions.")
+		logrus.WithError(err).Warnf("Failed to update structure cache")
 		return
 	}
 
-	for _, id := range regionIDs {
-		region, _, err := esiClient.ESI.UniverseApi.GetUniverseRegionsRegionId(nil, id, nil)
-		if err != nil {
-			logrus.WithError(err).Error("Could not get region info.")
-			return
-		}
-
-		// Store structures in cache (expire after 1 day, this has no effect)
-		cachedLocation := CachedLocation{
-			ID:        int64(region.RegionId),
-			ExpiresAt: time.Now().Unix() + 86400,
-			Location: pb.Location{
-				Region: &pb.Region{
-					Id:   int64(region.RegionId),
-					Name: region.Name,
-				},
-			},
-		}
-
-		err = putIntoCache(cachedLocation)
-		if err != nil {
-			logrus.WithError(err).Warn("Failed to store region")
-			return
-		}
-	}
-}
-
-func storeStructure(key string, structure Structure, expireAt int64) {
-	id, err := strconv.ParseInt(key, 10, 64)
+	stats, err := ingestStructures(allStructures, systems, time.Now())
 	if err != nil {
-		logrus.WithError(err).Warnf("Failed to parse structure ID")
+		logrus.WithError(err).Warnf("Failed to update structure cache")
 		return
 	}
 
-	system, err := getLocation(structure.SystemID)
-	if err != nil {
-		logrus.WithError(err).Warnf("Failed to fetch system")
-		return
-	}
+	publishIngestMetrics(stats)
+	logrus.WithFields(logrus.Fields{
+		"added":     stats.added,
+		"changed":   stats.changed,
+		"unchanged": stats.unchanged,
+		"removed":   stats.removed,
+	}).Info("Updated structures.")
+}
 
+// Create a structure's cache entry from the 3rd party API's data and its solar system
+func newStructureLocation(id int64, structure Structure, system *pb.Location, expireAt int64) (CachedLocation, error) {
 	lastSeenProto, err := ptypes.TimestampProto(structure.LastSeen)
 	if err != nil {
-		logrus.WithError(err).Warnf("Could not convert structure's last seen timestamp")
-		return
+		return CachedLocation{}, errors.Wrap(err, "could not convert structure's last seen timestamp")
 	}
 
 	firstSeenProto, err := ptypes.TimestampProto(structure.FirstSeen)
 	if err != nil {
-		logrus.WithError(err).Warnf("Could not convert structure's first seen timestamp")
-		return
+		return CachedLocation{}, errors.Wrap(err, "could not convert structure's first seen timestamp")
 	}
 
-	cachedLocation := CachedLocation{
+	coordinates := structure.Coordinates
+
+	return CachedLocation{
 		ID:        id,
 		ExpiresAt: expireAt,
+		Version:   cachedLocationVersion,
 		Location: pb.Location{
 			Region:        system.Region,
 			Constellation: system.Constellation,
@@ -205,44 +377,21 @@ func storeStructure(key string, structure Structure, expireAt int64) {
 				LastSeen:    lastSeenProto,
 				Public:      structure.Public,
 				FirstSeen:   firstSeenProto,
-				Coordinates: &structure.Coordinates,
+				Coordinates: &coordinates,
+				Status:      pb.Station_LIVE,
 			},
 		},
-	}
-
-	err = putIntoCache(cachedLocation)
-	if err != nil {
-		logrus.WithError(err).Warnf("Failed to store structure")
-		return
-	}
-}
-
-// Get a single location.
-func getLocation(id int64) (pb.Location, error) {
-	cachedLocation, err := getCachedLocation(id)
-
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	return cachedLocation.Location, nil
+	}, nil
 }
 
 // Get multiple locations by ID in parallel and return them as map indexed by ID, on error return partial result.
-func getLocations(ids []int64) (map[int64]*pb.Location, error) {
-	// Deduplicate IDs
-	ids = deduplicateIDs(ids)
-
-	response := make(map[int64]*pb.Location)
-	success := make(chan CachedLocation)
-	failure := make(chan error)
+// Only used by bulk updates, requests are run with low priority.
+func getLocations(ctx context.Context, ids []int64) (map[int64]*pb.Location, error) {
+	success, failure, ids := resolveLocations(ctx, ids, bulkPriority)
 	outstandingRequests := len(ids)
+	response := make(map[int64]*pb.Location)
 	failed := false
 
-	for _, id := range ids {
-		go getLocationAsync(id, success, failure)
-	}
-
 	for outstandingRequests > 0 {
 		select {
 		case location := <-success:
@@ -262,10 +411,65 @@ func getLocations(ids []int64) (map[int64]*pb.Location, error) {
 	return response, nil
 }
 
-func getLocationAsync(id int64, success chan CachedLocation, failure chan error) {
-	location, err := getCachedLocation(id)
+// Resolve multiple locations by ID in parallel on the worker pool. Returns channels the results are sent to and the
+// deduplicated IDs, one result per ID is sent. Channels are buffered so workers never block on consumers which
+// stopped listening. Once ctx is done, remaining IDs fail fast.
+func resolveLocations(ctx context.Context, ids []int64, jobPriority priority) (chan CachedLocation, chan *LocationError, []int64) {
+	// Deduplicate IDs
+	ids = deduplicateIDs(ids)
+
+	success := make(chan CachedLocation, len(ids))
+	failure := make(chan *LocationError, len(ids))
+
+	// Submit in background, so callers can consume results while jobs are still queued. Locations which have to be
+	// fetched from ESI are submitted last, after categorizing them in bulk.
+	go func() {
+		var coldIDs []int64
+		for _, id := range ids {
+			if isColdESILocation(id) {
+				coldIDs = append(coldIDs, id)
+				continue
+			}
+
+			submitLocation(ctx, id, jobPriority, success, failure)
+		}
+
+		if len(coldIDs) > 0 && ctx.Err() == nil {
+			logrus.Debugf("Categorizing %d locations", len(coldIDs))
+			err := categorizeLocations(ctx, coldIDs)
+			if err != nil {
+				logrus.WithError(err).Warn("Could not categorize locations in bulk")
+			}
+		}
+
+		for _, id := range coldIDs {
+			submitLocation(ctx, id, jobPriority, success, failure)
+		}
+	}()
+
+	return success, failure, ids
+}
+
+// Submit a job resolving a single location to the worker pool, fail right away if ctx is done before a worker is free
+func submitLocation(ctx context.Context, id int64, jobPriority priority, success chan CachedLocation, failure chan *LocationError) {
+	submitted := workers.submit(ctx, jobPriority, func() {
+		getLocationAsync(ctx, id, success, failure)
+	})
+	if !submitted {
+		failure <- toLocationError(id, upstreamError(id, ctx.Err()))
+	}
+}
+
+func getLocationAsync(ctx context.Context, id int64, success chan CachedLocation, failure chan *LocationError) {
+	// The job may have been queued for a while, don't start fetching for callers which gave up
+	if ctx.Err() != nil {
+		failure <- toLocationError(id, upstreamError(id, ctx.Err()))
+		return
+	}
+
+	location, err := getCachedLocation(ctx, id)
 	if err != nil {
-		failure <- err
+		failure <- toLocationError(id, err)
 		return
 	}
 	success <- location
@@ -273,7 +477,7 @@ func getLocationAsync(id int64, success chan CachedLocation, failure chan error)
 
 /* Try to get location from cache, if not present or outdated, update location from backend.
    If backend fails, return cached version. Only error if even backend-fetching failed. */
-func getCachedLocation(id int64) (CachedLocation, error) {
+func getCachedLocation(ctx context.Context, id int64) (CachedLocation, error) {
 	// Fetch from cache
 	location, needsUpdate, err := fetchLocationFromCache(id)
 	if err != nil {
@@ -282,14 +486,31 @@ func getCachedLocation(id int64) (CachedLocation, error) {
 
 	// Check if it needs an update
 	if needsUpdate {
-		location, err = updateLocationInCache(id)
+		// Serve expired entry right away if enabled, refresh it in background
+		if staleWhileRevalidate && location.ID != 0 {
+			scheduleRefresh(id)
+			metrics.Add(metricStaleServed, 1)
+			location.Stale = true
+			return location, nil
+		}
 
+		updatedLocation, err := updateLocationInCache(ctx, id)
 		if err != nil {
-			return location, err
+			// Serve expired entry if there is one
+			if location.ID == 0 {
+				return updatedLocation, err
+			}
+
+			logrus.WithError(err).Debugf("Serving stale location %d", id)
+			metrics.Add(metricStaleServed, 1)
+			location.Stale = true
+			return location, nil
 		}
+
+		location = updatedLocation
 	}
 
-	if location == (CachedLocation{}) {
+	if location.ID == 0 {
 		msg := fmt.Sprintf("could not get a valid location %d from cache and backend", id)
 		return location, errors.New(msg)
 	}
@@ -299,69 +520,71 @@ func getCachedLocation(id int64) (CachedLocation, error) {
 
 // Try to fetch location from cache and test if it needs to be updated.
 func fetchLocationFromCache(id int64) (location CachedLocation, needsUpdate bool, err error) {
-	var serializedLocation []byte
-	db.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket([]byte("locations"))
-		if bucket == nil {
-			panic("Bucket not found! This should never happen!")
+	// Check in-memory cache first, fall back to BoltDB
+	cachedLocation, ok := hotCache.get(id)
+	if !ok {
+		var serializedLocation []byte
+		db.View(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket([]byte("locations"))
+			if bucket == nil {
+				panic("Bucket not found! This should never happen!")
+			}
+
+			serializedLocation = bucket.Get([]byte(strconv.FormatInt(id, 10)))
+			return nil
+		})
+
+		if serializedLocation == nil {
+			return CachedLocation{}, true, nil
 		}
 
-		serializedLocation = bucket.Get([]byte(strconv.FormatInt(id, 10)))
-		return nil
-	})
+		err = cachedLocation.UnmarshalJSON(serializedLocation)
+		if err != nil {
+			return CachedLocation{}, true, err
+		}
 
-	if serializedLocation == nil {
-		return CachedLocation{}, true, nil
+		cachedLocation = hotCache.fill(cachedLocation)
 	}
 
-	var cachedLocation CachedLocation
-	err = cachedLocation.UnmarshalJSON(serializedLocation)
-	if err != nil {
-		return CachedLocation{}, true, err
-	}
-
-	// Check if location needs update, citadels and regions are updated via ticker
-	if cachedLocation.ID > 20000000 && cachedLocation.ID < 1000000000000 && cachedLocation.ExpiresAt < time.Now().Unix() {
+	// Check if location needs update (expired or stored by an older version), some sources (e.g. citadels and
+	// regions) are only updated via ticker
+	outdated := cachedLocation.ExpiresAt < time.Now().Unix() || cachedLocation.Version < cachedLocationVersion
+	if outdated && refreshesOnAccess(cachedLocation.ID) {
 		return cachedLocation, true, nil
 	}
 
 	return cachedLocation, false, nil
 }
 
-// Fetch a single location from backend and put it into cache.
-func updateLocationInCache(id int64) (CachedLocation, error) {
-	// Exclude citadels as they are updated in bulk via ticker
-	if id > 1000000000000 {
-		// This only happens if someone queries a citadel which is unknown
-		msg := fmt.Sprintf("Could not find citadel %d in current dataset", id)
-		return CachedLocation{}, errors.New(msg)
+// Fetch a single location from backend and put it into cache, concurrent updates of the same ID are coalesced.
+// The update is aborted once ctx is done for every caller waiting for it.
+func updateLocationInCache(ctx context.Context, id int64) (CachedLocation, error) {
+	location, err := locationUpdates.do(ctx, id, func(ctx context.Context) (interface{}, error) {
+		return refreshLocation(ctx, id)
+	})
+	if err != nil && err == ctx.Err() {
+		// Gave up waiting for the update
+		return CachedLocation{}, upstreamError(id, err)
 	}
 
+	cachedLocation, _ := location.(CachedLocation)
+	return cachedLocation, err
+}
+
+// Fetch a single location from backend and put it into cache, use updateLocationInCache instead of calling this.
+func refreshLocation(ctx context.Context, id int64) (CachedLocation, error) {
 	// Exclude implausible ID ranges
-	if id < 10000000 || id > 64000000 || (id >= 40000000 && id < 60000000) {
+	source := sourceFor(id)
+	if source == nil {
 		logrus.Debug(id)
-		return CachedLocation{}, errors.New("not a valid location ID range")
+		err := errors.New("not a valid location ID range")
+		return CachedLocation{}, &LocationError{ID: id, Reason: pb.LocationFailure_INVALID_RANGE, Err: err}
 	}
 
-	// Rest of requests are requests to ESI's location API.
-	rawLocation, err := fetchLocationFromESI(id)
+	// Expiry is set according to upstream's caching headers or the source's TTL
+	cachedLocation, err := source.Fetch(ctx, id)
 	if err != nil {
-		return CachedLocation{}, err
-	}
-
-	var expireAt int64
-	if id > 61000000 {
-		// Conquerable stations expire after an hour
-		expireAt = time.Now().Unix() + 3600
-	} else {
-		// Normal stations expire after a day
-		expireAt = time.Now().Unix() + 86400
-	}
-
-	cachedLocation := CachedLocation{
-		ID:        id,
-		ExpiresAt: expireAt,
-		Location:  rawLocation,
+		return CachedLocation{}, upstreamError(id, err)
 	}
 
 	err = putIntoCache(cachedLocation)
@@ -373,210 +596,74 @@ func updateLocationInCache(id int64) (CachedLocation, error) {
 func putIntoCache(cachedLocation CachedLocation) error {
 	logrus.Debugf("Storing location %d in cache", cachedLocation.ID)
 
-	var cachedLocationJSON []byte
-	cachedLocationJSON, err := cachedLocation.MarshalJSON()
-	if err != nil {
-		return err
-	}
-
 	// Batch calls as we're probably running this concurrently for lots of requests.
-	err = db.Batch(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket([]byte("locations"))
-		if bucket == nil {
-			panic("Bucket not found! This should never happen!")
-		}
-		key := []byte(strconv.FormatInt(cachedLocation.ID, 10))
-		err = bucket.Put(key, cachedLocationJSON)
-		return err
+	err := db.Batch(func(tx *bolt.Tx) error {
+		return storeLocation(tx, cachedLocation)
 	})
 
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	addToMemoryCaches(cachedLocation)
 
-// Fetches a location from ESI.
-func fetchLocationFromESI(id int64) (pb.Location, error) {
-	logrus.Debugf("Getting location %d from ESI", id)
-
-	// Check location type
-	locationType, response, err := esiClient.ESI.UniverseApi.PostUniverseNames(nil, []int32{int32(id)}, nil)
-	if err != nil {
-		msg := fmt.Sprintf("could not get location type of ID %d from ESI", id)
-		return pb.Location{}, errors.Wrap(err, msg)
-	}
-	if response.StatusCode != http.StatusOK {
-		msg := "Invalid HTTP status when querying location type!"
-		return pb.Location{}, errors.New(msg)
-	}
-	if len(locationType) == 0 {
-		msg := "No location type returned by ESI!"
-		return pb.Location{}, errors.New(msg)
-	}
-
-	// Get and return location
-	switch locationType[0].Category {
-	case "station":
-		return fetchStation(id)
-	case "solar_system":
-		return fetchSolarSystem(id)
-	case "constellation":
-		return fetchConstellation(id)
-	case "region":
-		return fetchRegion(id)
-	default:
-		msg := fmt.Sprintf("Unhandled category '%s'!", locationType[0].Category)
-		return pb.Location{}, errors.New(msg)
-	}
-}
-
-// Fetch a station from ESI
-func fetchStation(id int64) (pb.Location, error) {
-	// Check if recent version is available in cache
-	cachedStation, needsUpdate, err := fetchLocationFromCache(id)
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	// Return cached version
-	if !needsUpdate {
-		return cachedStation.Location, nil
-	}
-
-	logrus.WithField("station_id", id).Debug("Loading station from ESI.")
-
-	// Fetch from ESI if not in cache
-	station, _, err := esiClient.ESI.UniverseApi.GetUniverseStationsStationId(nil, int32(id), nil)
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	// Get solar system
-	solarSystem, err := fetchSolarSystem(int64(station.SystemId))
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	coordinates := pb.Coordinates{
-		X: float64(station.Position.X),
-		Y: float64(station.Position.Y),
-		Z: float64(station.Position.Z),
-	}
-
-	location := pb.Location(solarSystem)
-	location.Station = &pb.Station{
-		Id:          int64(station.StationId),
-		Name:        station.Name,
-		TypeId:      int64(station.TypeId),
-		Public:      true,
-		Coordinates: &coordinates,
-	}
-
-	return location, nil
+	return nil
 }
 
-// Fetch a solar system from ESI
-func fetchSolarSystem(id int64) (pb.Location, error) {
-	// Check if recent version is available in cache
-	cachedSolarSystem, needsUpdate, err := fetchLocationFromCache(id)
+// Store a location and update the secondary indexes, must be called within a writable transaction. The in-memory
+// caches must be updated via addToMemoryCaches once the transaction was committed.
+func storeLocation(tx *bolt.Tx, cachedLocation CachedLocation) error {
+	cachedLocationJSON, err := cachedLocation.MarshalJSON()
 	if err != nil {
-		return pb.Location{}, err
+		return err
 	}
 
-	// Return cached version
-	if !needsUpdate {
-		return cachedSolarSystem.Location, nil
+	bucket := tx.Bucket([]byte("locations"))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
 	}
 
-	logrus.WithField("solar_system_id", id).Debug("Loading solar system from ESI.")
-
-	// Fetch from ESI if not in cache
-	solarSystem, _, err := esiClient.ESI.UniverseApi.GetUniverseSystemsSystemId(nil, int32(id), nil)
+	// The previous version is needed for removing the location from the indexes of parents it moved away from
+	var previousLocation *pb.Location
+	previous, err := readLocation(tx, cachedLocation.ID)
 	if err != nil {
-		return pb.Location{}, err
+		logrus.WithError(err).Warnf("Could not read previous version of location %d", cachedLocation.ID)
+	} else {
+		previousLocation = &previous.Location
 	}
 
-	// Get constellation
-	constellation, err := fetchConstellation(int64(solarSystem.ConstellationId))
+	key := []byte(strconv.FormatInt(cachedLocation.ID, 10))
+	err = bucket.Put(key, cachedLocationJSON)
 	if err != nil {
-		return pb.Location{}, err
-	}
-
-	location := pb.Location(constellation)
-	location.SolarSystem = &pb.SolarSystem{
-		Id:             int64(solarSystem.SystemId),
-		Name:           solarSystem.Name,
-		SecurityStatus: float64(solarSystem.SecurityStatus),
+		return err
 	}
 
-	return location, nil
+	return indexLocation(tx, previousLocation, cachedLocation)
 }
 
-// Fetch a constellation from ESI
-func fetchConstellation(id int64) (pb.Location, error) {
-	// Check if recent version is available in cache
-	cachedConstellation, needsUpdate, err := fetchLocationFromCache(id)
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	// Return cached version
-	if !needsUpdate {
-		return cachedConstellation.Location, nil
-	}
-
-	logrus.WithField("constellation_id", id).Debug("Loading constellation from ESI.")
-
-	// Fetch from ESI if not in cache
-	constellation, _, err := esiClient.ESI.UniverseApi.GetUniverseConstellationsConstellationId(nil, int32(id), nil)
-	if err != nil {
-		return pb.Location{}, err
-	}
+// Read a location stored in BoltDB, returns an empty location if it is not stored
+func readLocation(tx *bolt.Tx, id int64) (CachedLocation, error) {
+	var cachedLocation CachedLocation
 
-	// Get region
-	region, err := fetchRegion(int64(constellation.RegionId))
-	if err != nil {
-		return pb.Location{}, err
+	bucket := tx.Bucket([]byte("locations"))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
 	}
 
-	location := pb.Location(region)
-	location.Constellation = &pb.Constellation{
-		Id:   int64(constellation.ConstellationId),
-		Name: constellation.Name,
+	serializedLocation := bucket.Get([]byte(strconv.FormatInt(id, 10)))
+	if serializedLocation == nil {
+		return cachedLocation, nil
 	}
 
-	return location, nil
+	err := cachedLocation.UnmarshalJSON(serializedLocation)
+	return cachedLocation, err
 }
 
-// Fetch a region from ESI
-func fetchRegion(id int64) (pb.Location, error) {
-	// Check if recent version is available in cache
-	cachedRegion, needsUpdate, err := fetchLocationFromCache(id)
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	// Return cached version
-	if !needsUpdate {
-		return cachedRegion.Location, nil
-	}
-
-	logrus.WithField("region_id", id).Debug("Loading region from ESI.")
-
-	// Fetch from ESI if not in cache
-	region, _, err := esiClient.ESI.UniverseApi.GetUniverseRegionsRegionId(nil, int32(id), nil)
-	if err != nil {
-		return pb.Location{}, err
-	}
-
-	return pb.Location{
-		Region: &pb.Region{
-			Id:   int64(region.RegionId),
-			Name: region.Name,
-		},
-	}, nil
+// Update the in-memory caches and indexes with a stored location
+func addToMemoryCaches(cachedLocation CachedLocation) {
+	hotCache.put(cachedLocation)
+	searchIndex.add(cachedLocation)
+	spatialIndex.add(cachedLocation)
 }
 
 // Deduplicate a slice of integers
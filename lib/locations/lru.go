@@ -0,0 +1,86 @@
+package locations
+
+import (
+	"container/list"
+	"sync"
+)
+
+// locationLRU is a size-bounded in-memory cache of decoded locations in front of BoltDB. Once full, the least recently
+// used entries are evicted. A capacity of zero disables the cache.
+type locationLRU struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[int64]*list.Element
+	order    *list.List
+}
+
+func newLocationLRU(capacity int) *locationLRU {
+	return &locationLRU{
+		capacity: capacity,
+		entries:  make(map[int64]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get a location from cache and mark it as recently used
+func (cache *locationLRU) get(id int64) (CachedLocation, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[id]
+	if !ok {
+		return CachedLocation{}, false
+	}
+
+	cache.order.MoveToFront(element)
+	return element.Value.(CachedLocation), true
+}
+
+// Add or replace a location, evict least recently used entry if cache is full. Only used by writers, which store the
+// location in BoltDB first.
+func (cache *locationLRU) put(location CachedLocation) {
+	if cache.capacity <= 0 {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[location.ID]; ok {
+		element.Value = location
+		cache.order.MoveToFront(element)
+		return
+	}
+
+	cache.insert(location)
+}
+
+// Add a location read from BoltDB unless it is already cached, returns the cached location. A writer may have stored
+// a newer version while it was read, which must not be replaced.
+func (cache *locationLRU) fill(location CachedLocation) CachedLocation {
+	if cache.capacity <= 0 {
+		return location
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[location.ID]; ok {
+		cache.order.MoveToFront(element)
+		return element.Value.(CachedLocation)
+	}
+
+	cache.insert(location)
+	return location
+}
+
+// Insert a location which is not cached yet, must be called with the mutex held
+func (cache *locationLRU) insert(location CachedLocation) {
+	cache.entries[location.ID] = cache.order.PushFront(location)
+
+	if cache.order.Len() > cache.capacity {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(CachedLocation).ID)
+	}
+}
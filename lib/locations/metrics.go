@@ -0,0 +1,50 @@
+package locations
+
+import "expvar"
+
+// Metrics are published via expvar under the "locations" key
+var metrics = expvar.NewMap("locations")
+
+// Names of published metrics
+const (
+	// Expired entries served because the backend failed or stale-while-revalidate is enabled
+	metricStaleServed = "staleServed"
+	// Background refreshes of expired entries
+	metricRefreshesScheduled = "refreshesScheduled"
+	metricRefreshesSucceeded = "refreshesSucceeded"
+	metricRefreshesFailed    = "refreshesFailed"
+	metricRefreshesPending   = "refreshesPending"
+	// Progress of the current (or last) universe crawl
+	metricCrawlRunning    = "crawlRunning"
+	metricCrawlDiscovered = "crawlDiscovered"
+	metricCrawlResolved   = "crawlResolved"
+	metricCrawlFailed     = "crawlFailed"
+	// Crawls finished since start
+	metricCrawlsCompleted = "crawlsCompleted"
+	// Outcome of the last structure feed refresh
+	metricStructuresAdded     = "structuresAdded"
+	metricStructuresChanged   = "structuresChanged"
+	metricStructuresUnchanged = "structuresUnchanged"
+	metricStructuresRemoved   = "structuresRemoved"
+)
+
+// Reset progress of the universe crawl before a new one is started
+func resetCrawlMetrics() {
+	metrics.Set(metricCrawlDiscovered, new(expvar.Int))
+	metrics.Set(metricCrawlResolved, new(expvar.Int))
+	metrics.Set(metricCrawlFailed, new(expvar.Int))
+}
+
+// Publish the outcome of the last structure feed refresh
+func publishIngestMetrics(stats ingestStats) {
+	for key, count := range map[string]int{
+		metricStructuresAdded:     stats.added,
+		metricStructuresChanged:   stats.changed,
+		metricStructuresUnchanged: stats.unchanged,
+		metricStructuresRemoved:   stats.removed,
+	} {
+		value := new(expvar.Int)
+		value.Set(int64(count))
+		metrics.Set(key, value)
+	}
+}
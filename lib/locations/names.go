@@ -0,0 +1,199 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Maximum number of IDs accepted by ESI's /universe/names endpoint
+const namesBatchSize = 1000
+
+// Categories (station, solar_system, ...) of IDs as returned by ESI. An ID's category never changes, so entries are
+// kept for the lifetime of the process.
+var locationCategories = make(map[int64]string)
+var locationCategoriesMutex sync.RWMutex
+
+// Names of corporations owning stations and when they were resolved
+type corporationName struct {
+	name       string
+	resolvedAt time.Time
+}
+
+var corporationNames = make(map[int64]corporationName)
+var corporationNamesMutex sync.RWMutex
+
+// Concurrent lookups of the same corporation's name, e.g. when all of its stations are refreshed at once
+var corporationNameLookups = newInflightGroup()
+
+// Get a location's category, ask ESI if it is unknown.
+func getLocationCategory(ctx context.Context, id int64) (string, error) {
+	locationCategoriesMutex.RLock()
+	category, ok := locationCategories[id]
+	locationCategoriesMutex.RUnlock()
+	if ok {
+		return category, nil
+	}
+
+	err := categorizeLocations(ctx, []int64{id})
+	if err != nil {
+		msg := fmt.Sprintf("could not get location type of ID %d from ESI", id)
+		return "", errors.Wrap(err, msg)
+	}
+
+	locationCategoriesMutex.RLock()
+	category, ok = locationCategories[id]
+	locationCategoriesMutex.RUnlock()
+	if !ok {
+		// Not categorized as ESI does not know the ID
+		unknown, err := isUnknownLocation(id)
+		if err == nil && unknown {
+			return "", unknownLocationError(id)
+		}
+
+		return "", errors.New("No location type returned by ESI!")
+	}
+
+	return category, nil
+}
+
+// Remember a location's category if it is already known, e.g. from the location's parent.
+func setLocationCategory(id int64, category string) {
+	locationCategoriesMutex.Lock()
+	locationCategories[id] = category
+	locationCategoriesMutex.Unlock()
+}
+
+// Categorize locations with as few calls to ESI as possible. IDs ESI recently reported as not found are skipped.
+func categorizeLocations(ctx context.Context, ids []int64) error {
+	var candidates []int64
+	for _, id := range ids {
+		unknown, err := isUnknownLocation(id)
+		if err != nil {
+			return err
+		}
+
+		if !unknown {
+			candidates = append(candidates, id)
+		}
+	}
+
+	for start := 0; start < len(candidates); start += namesBatchSize {
+		end := start + namesBatchSize
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+
+		err := categorizeBatch(ctx, candidates[start:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Categorize a batch of locations. ESI rejects the whole batch if a single ID is unknown, such batches are split in half
+// until the unknown IDs are isolated, these are remembered so they are not requested again.
+func categorizeBatch(ctx context.Context, ids []int64) error {
+	batch := make([]int32, len(ids))
+	for i, id := range ids {
+		batch[i] = int32(id)
+	}
+
+	names, response, err := esiClient.ESI.UniverseApi.PostUniverseNames(ctx, batch, nil)
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		if len(ids) == 1 {
+			err = rememberUnknownLocation(ids[0], time.Now().Add(unknownLocationTTL))
+			if err != nil {
+				logrus.WithError(err).Warnf("Could not remember unknown location %d", ids[0])
+			}
+
+			return nil
+		}
+
+		middle := len(ids) / 2
+		err = categorizeBatch(ctx, ids[:middle])
+		if err != nil {
+			return err
+		}
+
+		return categorizeBatch(ctx, ids[middle:])
+	}
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return errors.New("Invalid HTTP status when querying location type!")
+	}
+
+	locationCategoriesMutex.Lock()
+	for _, name := range names {
+		locationCategories[int64(name.Id)] = name.Category
+	}
+	locationCategoriesMutex.Unlock()
+
+	return nil
+}
+
+// Check whether a location is not in cache (or expired), is resolved via ESI's location API and has no known category.
+func isColdESILocation(id int64) bool {
+	switch sourceFor(id).(type) {
+	case esiUniverseSource, esiStationSource:
+	default:
+		return false
+	}
+
+	locationCategoriesMutex.RLock()
+	_, ok := locationCategories[id]
+	locationCategoriesMutex.RUnlock()
+	if ok {
+		return false
+	}
+
+	_, needsUpdate, err := fetchLocationFromCache(id)
+	return err == nil && needsUpdate
+}
+
+// Get a corporation's name. Names resolved longer than maxAge ago are resolved again, so they are kept as fresh as the
+// stations referencing them.
+func getCorporationName(ctx context.Context, id int64, maxAge time.Duration) (string, error) {
+	corporationNamesMutex.RLock()
+	cached, ok := corporationNames[id]
+	corporationNamesMutex.RUnlock()
+	if ok && time.Since(cached.resolvedAt) < maxAge {
+		return cached.name, nil
+	}
+
+	name, err := corporationNameLookups.do(ctx, id, func(ctx context.Context) (interface{}, error) {
+		names, response, err := esiClient.ESI.UniverseApi.PostUniverseNames(ctx, []int32{int32(id)}, nil)
+		if err != nil {
+			return "", err
+		}
+		if response.StatusCode != http.StatusOK || len(names) == 0 {
+			return "", errors.New("Invalid response when querying corporation name!")
+		}
+
+		corporationNamesMutex.Lock()
+		corporationNames[id] = corporationName{name: names[0].Name, resolvedAt: time.Now()}
+		corporationNamesMutex.Unlock()
+
+		return names[0].Name, nil
+	})
+	if err != nil {
+		// Prefer an outdated name over none
+		if ok {
+			return cached.name, nil
+		}
+
+		msg := fmt.Sprintf("could not get name of corporation %d from ESI", id)
+		return "", errors.Wrap(err, msg)
+	}
+
+	return name.(string), nil
+}
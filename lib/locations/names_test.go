@@ -0,0 +1,89 @@
+package locations
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+)
+
+// fakeNames emulates ESI's /universe/names endpoint, which rejects a whole request if a single ID is unknown
+type fakeNames struct {
+	mutex    sync.Mutex
+	unknown  map[int64]bool
+	requests int
+}
+
+func (names *fakeNames) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	names.mutex.Lock()
+	defer names.mutex.Unlock()
+
+	names.requests++
+
+	var ids []int64
+	err := json.NewDecoder(request.Body).Decode(&ids)
+	if err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var response []map[string]interface{}
+	for _, id := range ids {
+		if names.unknown[id] {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		response = append(response, map[string]interface{}{"id": id, "name": "Station", "category": "station"})
+	}
+
+	writeJSON(writer, response)
+}
+
+func TestCategorizeLocationsIsolatesUnknownIDs(t *testing.T) {
+	upstream := &fakeNames{unknown: map[int64]bool{60000004: true, 60000007: true}}
+	_, cleanup := setupTestCache(t, upstream)
+	defer cleanup()
+
+	ids := []int64{60000001, 60000002, 60000003, 60000004, 60000005, 60000006, 60000007, 60000008}
+	err := categorizeLocations(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range ids {
+		locationCategoriesMutex.RLock()
+		category, categorized := locationCategories[id]
+		locationCategoriesMutex.RUnlock()
+
+		unknown, err := isUnknownLocation(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch {
+		case upstream.unknown[id] && (categorized || !unknown):
+			t.Errorf("expected %d to be remembered as unknown", id)
+		case !upstream.unknown[id] && (category != "station" || unknown):
+			t.Errorf("expected %d to be categorized as station, got %q", id, category)
+		}
+	}
+
+	// Unknown IDs are neither requested in bulk...
+	requests := upstream.requests
+	err = categorizeLocations(context.Background(), []int64{60000004, 60000007})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ...nor on their own
+	_, err = getLocationCategory(context.Background(), 60000004)
+	assertFailure(t, err, pb.LocationFailure_UNKNOWN)
+
+	if upstream.requests != requests {
+		t.Errorf("expected unknown IDs not to be requested again, got %d requests", upstream.requests-requests)
+	}
+}
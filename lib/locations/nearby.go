@@ -0,0 +1,236 @@
+package locations
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/EVE-Tools/static-data/lib/navigation"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Meters per astronomical unit
+const metersPerAU = 149597870700
+
+// Maximum number of jumps searched by FindNearby
+const maxNearbyJumps = 10
+
+// FindNearby returns stations, structures or celestials near a location, nearest first
+func FindNearby(context context.Context, request *pb.FindNearbyRequest) (*pb.FindNearbyResponse, error) {
+	if request.GetMaxJumps() < 0 || request.GetMaxJumps() > maxNearbyJumps {
+		return nil, status.Error(codes.InvalidArgument, "Jumps must be between 0 and 10")
+	}
+
+	origin, err := getCachedLocation(context, request.GetOriginId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Origin not found")
+	}
+	if origin.Location.SolarSystem == nil {
+		return nil, status.Error(codes.InvalidArgument, "Origin must be a station, structure, celestial or solar system")
+	}
+
+	systemID := origin.Location.SolarSystem.Id
+	originCoordinates := coordinatesOf(&origin.Location)
+	if origin.ID == systemID {
+		originCoordinates = request.GetCoordinates()
+	}
+
+	if request.GetMaxDistanceAu() > 0 && originCoordinates == nil {
+		return nil, status.Error(codes.InvalidArgument, "Coordinates must be set to limit the distance from a solar system")
+	}
+
+	// Systems to search mapped to their distance in jumps
+	systemJumps := map[int64]int{systemID: 0}
+	if request.GetMaxJumps() > 0 {
+		systemJumps, err = navigation.SystemsWithinJumps(systemID, int(request.GetMaxJumps()))
+		if err != nil {
+			logrus.WithError(err).Warn("could not get systems in range")
+			return nil, status.Error(codes.Unavailable, "Stargate graph not available")
+		}
+	}
+
+	kinds := map[pb.LocationKind]struct{}{
+		pb.LocationKind_STATION:   {},
+		pb.LocationKind_STRUCTURE: {},
+	}
+	if len(request.GetKinds()) > 0 {
+		kinds = make(map[pb.LocationKind]struct{})
+		for _, kind := range request.GetKinds() {
+			kinds[kind] = struct{}{}
+		}
+	}
+
+	var results []*pb.NearbyLocation
+	for searchedSystemID, jumps := range systemJumps {
+		for _, entry := range spatialIndex.inSystem(searchedSystemID) {
+			if _, ok := kinds[entry.kind]; !ok || entry.id == origin.ID {
+				continue
+			}
+
+			if request.GetPublicOnly() && !entry.public {
+				continue
+			}
+
+			if entry.hidden && !request.GetIncludeRemoved() {
+				continue
+			}
+
+			result := &pb.NearbyLocation{
+				Id:    entry.id,
+				Kind:  entry.kind,
+				Jumps: int32(jumps),
+			}
+
+			// Distances are only meaningful within the origin's system
+			if jumps == 0 && originCoordinates != nil && entry.coordinates != nil {
+				result.DistanceAu = distanceAU(originCoordinates, entry.coordinates)
+				if request.GetMaxDistanceAu() > 0 && result.DistanceAu > request.GetMaxDistanceAu() {
+					continue
+				}
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Jumps != results[j].Jumps {
+			return results[i].Jumps < results[j].Jumps
+		}
+
+		if results[i].DistanceAu != results[j].DistanceAu {
+			return results[i].DistanceAu < results[j].DistanceAu
+		}
+
+		return results[i].Id < results[j].Id
+	})
+
+	limit := int(request.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	// Attach cached locations
+	for _, result := range results {
+		cachedLocation, _, err := fetchLocationFromCache(result.Id)
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not get location %d from cache", result.Id)
+			continue
+		}
+
+		result.Location = &cachedLocation.Location
+	}
+
+	return &pb.FindNearbyResponse{Results: results}, nil
+}
+
+// Index of the positions of all cached stations, structures and celestials
+var spatialIndex = newSystemIndex()
+
+// A location with coordinates in the spatial index
+type spatialEntry struct {
+	id          int64
+	kind        pb.LocationKind
+	public      bool
+	hidden      bool
+	coordinates *pb.Coordinates
+}
+
+// systemIndex holds the positions of cached locations grouped by solar system. Systems contain a few hundred
+// locations at most, so they are scanned linearly.
+type systemIndex struct {
+	mutex   sync.RWMutex
+	systems map[int64]map[int64]spatialEntry
+	// Solar system each location is listed under, used for removing locations which moved
+	systemOf map[int64]int64
+}
+
+func newSystemIndex() *systemIndex {
+	return &systemIndex{
+		systems:  make(map[int64]map[int64]spatialEntry),
+		systemOf: make(map[int64]int64),
+	}
+}
+
+// Add or replace a location, it is removed from the system it was listed under before. Locations without a solar
+// system or coordinates are not listed.
+func (index *systemIndex) add(cachedLocation CachedLocation) {
+	location := &cachedLocation.Location
+	kind, _ := describeLocation(location)
+	coordinates := coordinatesOf(location)
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if previousSystemID, ok := index.systemOf[cachedLocation.ID]; ok {
+		delete(index.systems[previousSystemID], cachedLocation.ID)
+		if len(index.systems[previousSystemID]) == 0 {
+			delete(index.systems, previousSystemID)
+		}
+		delete(index.systemOf, cachedLocation.ID)
+	}
+
+	if location.SolarSystem == nil || coordinates == nil {
+		return
+	}
+
+	entry := spatialEntry{
+		id:          cachedLocation.ID,
+		kind:        kind,
+		public:      location.Station == nil || location.Station.Public,
+		hidden:      isHidden(location),
+		coordinates: coordinates,
+	}
+
+	system, ok := index.systems[location.SolarSystem.Id]
+	if !ok {
+		system = make(map[int64]spatialEntry)
+		index.systems[location.SolarSystem.Id] = system
+	}
+	system[cachedLocation.ID] = entry
+	index.systemOf[cachedLocation.ID] = location.SolarSystem.Id
+}
+
+// Get all locations in a system
+func (index *systemIndex) inSystem(systemID int64) []spatialEntry {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	entries := make([]spatialEntry, 0, len(index.systems[systemID]))
+	for _, entry := range index.systems[systemID] {
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// Get the coordinates of a station, structure or celestial
+func coordinatesOf(location *pb.Location) *pb.Coordinates {
+	switch {
+	case location.Celestial != nil:
+		return location.Celestial.Coordinates
+	case location.Station != nil:
+		return location.Station.Coordinates
+	default:
+		return nil
+	}
+}
+
+// Get the distance between two points in AU
+func distanceAU(from *pb.Coordinates, to *pb.Coordinates) float64 {
+	dx := from.X - to.X
+	dy := from.Y - to.Y
+	dz := from.Z - to.Z
+
+	return math.Sqrt(dx*dx+dy*dy+dz*dz) / metersPerAU
+}
@@ -0,0 +1,484 @@
+package locations
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/ptypes"
+	google_pb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maps structure IDs onto admins' overrides of the structure feed
+const structureOverridesBucket = "structureOverrides"
+
+// Contains a nested bucket per structure, which maps zero-padded sequence numbers to changes of its override. Entries
+// are kept when the override is deleted.
+const overrideAuditBucket = "structureOverrideAudit"
+
+// UpsertStructureOverride creates or replaces a structure's override and applies it to the cached structure right away.
+// The change is recorded in the audit trail along with the authenticated admin.
+func UpsertStructureOverride(ctx context.Context, admin string, request *pb.UpsertStructureOverrideRequest) (*pb.StructureOverride, error) {
+	override := request.GetOverride()
+	if override == nil || !(structureFeedSource{}).Claims(override.StructureId) {
+		return nil, status.Error(codes.InvalidArgument, "Override must be set for a structure ID")
+	}
+
+	if request.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason must be set")
+	}
+
+	// Check the solar system before storing anything
+	var system *pb.Location
+	if override.SolarSystemId != 0 {
+		cachedSystem, err := getCachedLocation(ctx, override.SolarSystemId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Solar system not found")
+		}
+
+		if kind, _ := describeLocation(&cachedSystem.Location); kind != pb.LocationKind_SOLAR_SYSTEM {
+			return nil, status.Error(codes.InvalidArgument, "Solar system not found")
+		}
+
+		system = &cachedSystem.Location
+	}
+
+	storedOverride := StoredOverride{Override: *override}
+	storedOverride.Override.AuditTrail = nil
+
+	now := time.Now()
+	var updated CachedLocation
+	var response *pb.StructureOverride
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		err := putOverride(tx, storedOverride)
+		if err != nil {
+			return err
+		}
+
+		err = appendAuditEntry(tx, override.StructureId, OverrideAuditEntry{
+			Author:    admin,
+			Reason:    request.GetReason(),
+			ChangedAt: now.Unix(),
+			Action:    pb.OverrideAuditEntry_UPSERT,
+		})
+		if err != nil {
+			return err
+		}
+
+		response, err = readOverride(tx, override.StructureId)
+		if err != nil {
+			return err
+		}
+
+		stored, err := readLocation(tx, override.StructureId)
+		if err != nil {
+			return err
+		}
+
+		key := []byte(strconv.FormatInt(override.StructureId, 10))
+		inFeed := tx.Bucket([]byte(feedStructuresBucket)).Get(key) != nil
+
+		var ok bool
+		switch {
+		case !inFeed:
+			expireAt := now.Add(structureFeedSource{}.TTL(0)).Unix()
+			updated, ok = overrideUnfedStructure(stored, &storedOverride.Override, system, expireAt)
+		case stored.ID != 0 && stored.Location.Station != nil:
+			updated, ok = applyOverride(stored, &storedOverride.Override, system), true
+		}
+
+		// The override is applied once the structure shows up
+		if !ok {
+			return nil
+		}
+
+		err = storeLocation(tx, updated)
+		if err != nil {
+			return err
+		}
+
+		return recordStructureSnapshot(tx, stored, updated, now)
+	})
+	if err != nil {
+		logrus.WithError(err).Error("could not store structure override")
+		return nil, status.Error(codes.Internal, "Error storing override")
+	}
+
+	if updated.ID != 0 {
+		addToMemoryCaches(updated)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"structure_id": override.StructureId,
+		"author":       admin,
+		"reason":       request.GetReason(),
+	}).Info("Structure override stored.")
+
+	return response, nil
+}
+
+// DeleteStructureOverride deletes a structure's override. Structures from the structure feed are restored by its next
+// refresh, structures only known from the override are marked as removed. The change is recorded in the audit trail
+// along with the authenticated admin.
+func DeleteStructureOverride(ctx context.Context, admin string, request *pb.DeleteStructureOverrideRequest) (*google_pb.Empty, error) {
+	if request.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason must be set")
+	}
+
+	id := request.GetStructureId()
+	now := time.Now()
+	var updated CachedLocation
+	var found bool
+
+	removedAt, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error deleting override")
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		override, err := readOverride(tx, id)
+		if err != nil || override == nil {
+			return err
+		}
+		found = true
+
+		err = tx.Bucket([]byte(structureOverridesBucket)).Delete([]byte(strconv.FormatInt(id, 10)))
+		if err != nil {
+			return err
+		}
+
+		err = appendAuditEntry(tx, id, OverrideAuditEntry{
+			Author:    admin,
+			Reason:    request.GetReason(),
+			ChangedAt: now.Unix(),
+			Action:    pb.OverrideAuditEntry_DELETE,
+		})
+		if err != nil {
+			return err
+		}
+
+		if tx.Bucket([]byte(feedStructuresBucket)).Get([]byte(strconv.FormatInt(id, 10))) != nil {
+			return nil
+		}
+
+		stored, err := readLocation(tx, id)
+		if err != nil || stored.ID == 0 || stored.Location.Station == nil {
+			return err
+		}
+
+		if stored.Location.Station.Status == pb.Station_REMOVED {
+			return nil
+		}
+
+		updated = markRemoved(stored, removedAt)
+		err = storeLocation(tx, updated)
+		if err != nil {
+			return err
+		}
+
+		return recordStructureSnapshot(tx, stored, updated, now)
+	})
+	if err != nil {
+		logrus.WithError(err).Error("could not delete structure override")
+		return nil, status.Error(codes.Internal, "Error deleting override")
+	}
+
+	if !found {
+		return nil, status.Error(codes.NotFound, "Override not found")
+	}
+
+	if updated.ID != 0 {
+		addToMemoryCaches(updated)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"structure_id": id,
+		"author":       admin,
+		"reason":       request.GetReason(),
+	}).Info("Structure override deleted.")
+
+	return &google_pb.Empty{}, nil
+}
+
+// ListStructureOverrides returns all structure overrides along with their audit trail
+func ListStructureOverrides(ctx context.Context, request *google_pb.Empty) (*pb.ListStructureOverridesResponse, error) {
+	var response pb.ListStructureOverridesResponse
+
+	err := db.View(func(tx *bolt.Tx) error {
+		overrides, err := readOverrides(tx)
+		if err != nil {
+			return err
+		}
+
+		for id, override := range overrides {
+			override.AuditTrail, err = readAuditTrail(tx, id)
+			if err != nil {
+				return err
+			}
+
+			response.Overrides = append(response.Overrides, override)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Error("could not read structure overrides")
+		return nil, status.Error(codes.Internal, "Error reading overrides")
+	}
+
+	sort.Slice(response.Overrides, func(i, j int) bool {
+		return response.Overrides[i].StructureId < response.Overrides[j].StructureId
+	})
+
+	return &response, nil
+}
+
+// Get all overrides indexed by structure ID, without audit trail
+func readOverrides(tx *bolt.Tx) (map[int64]*pb.StructureOverride, error) {
+	bucket := tx.Bucket([]byte(structureOverridesBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	overrides := make(map[int64]*pb.StructureOverride)
+	err := bucket.ForEach(func(key []byte, value []byte) error {
+		var storedOverride StoredOverride
+		err := storedOverride.UnmarshalJSON(value)
+		if err != nil {
+			return err
+		}
+
+		overrides[storedOverride.Override.StructureId] = &storedOverride.Override
+		return nil
+	})
+
+	return overrides, err
+}
+
+// Get a structure's override including its audit trail, nil if there is none
+func readOverride(tx *bolt.Tx, id int64) (*pb.StructureOverride, error) {
+	bucket := tx.Bucket([]byte(structureOverridesBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	value := bucket.Get([]byte(strconv.FormatInt(id, 10)))
+	if value == nil {
+		return nil, nil
+	}
+
+	var storedOverride StoredOverride
+	err := storedOverride.UnmarshalJSON(value)
+	if err != nil {
+		return nil, err
+	}
+
+	override := storedOverride.Override
+	override.AuditTrail, err = readAuditTrail(tx, id)
+	return &override, err
+}
+
+// Store a structure's override, replaces the existing one
+func putOverride(tx *bolt.Tx, storedOverride StoredOverride) error {
+	bucket := tx.Bucket([]byte(structureOverridesBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	overrideJSON, err := storedOverride.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte(strconv.FormatInt(storedOverride.Override.StructureId, 10)), overrideJSON)
+}
+
+// Add an entry to a structure's audit trail
+func appendAuditEntry(tx *bolt.Tx, id int64, entry OverrideAuditEntry) error {
+	bucket := tx.Bucket([]byte(overrideAuditBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	structure, err := bucket.CreateBucketIfNotExists([]byte(strconv.FormatInt(id, 10)))
+	if err != nil {
+		return err
+	}
+
+	entryJSON, err := entry.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return appendToBucket(structure, entryJSON)
+}
+
+// Get a structure's audit trail, oldest first
+func readAuditTrail(tx *bolt.Tx, id int64) ([]*pb.OverrideAuditEntry, error) {
+	bucket := tx.Bucket([]byte(overrideAuditBucket))
+	if bucket == nil {
+		panic("Bucket not found! This should never happen!")
+	}
+
+	structure := bucket.Bucket([]byte(strconv.FormatInt(id, 10)))
+	if structure == nil {
+		return nil, nil
+	}
+
+	var trail []*pb.OverrideAuditEntry
+	err := structure.ForEach(func(key []byte, value []byte) error {
+		var entry OverrideAuditEntry
+		err := entry.UnmarshalJSON(value)
+		if err != nil {
+			return err
+		}
+
+		changedAt, err := ptypes.TimestampProto(time.Unix(entry.ChangedAt, 0))
+		if err != nil {
+			return err
+		}
+
+		trail = append(trail, &pb.OverrideAuditEntry{
+			Author:    entry.Author,
+			Reason:    entry.Reason,
+			ChangedAt: changedAt,
+			Action:    entry.Action,
+		})
+		return nil
+	})
+
+	return trail, err
+}
+
+// Get a copy of a cached structure with an override applied, system is the override's solar system (if set)
+func applyOverride(cachedLocation CachedLocation, override *pb.StructureOverride, system *pb.Location) CachedLocation {
+	// Copy the station, the cached one may be shared with concurrent readers
+	station := *cachedLocation.Location.Station
+
+	if override.Name != "" {
+		station.Name = override.Name
+	}
+
+	if override.TypeId != 0 {
+		station.TypeId = override.TypeId
+	}
+
+	if override.TypeName != "" {
+		station.TypeName = override.TypeName
+	}
+
+	if override.Coordinates != nil {
+		coordinates := *override.Coordinates
+		station.Coordinates = &coordinates
+	}
+
+	switch override.Visibility {
+	case pb.StructureOverride_PUBLIC:
+		station.Public = true
+	case pb.StructureOverride_PRIVATE:
+		station.Public = false
+	}
+
+	switch {
+	case override.Suppressed:
+		station.Status = pb.Station_SUPPRESSED
+	case station.Status == pb.Station_SUPPRESSED:
+		station.Status = pb.Station_LIVE
+	}
+
+	if system != nil && override.SolarSystemId != 0 {
+		cachedLocation.Location.Region = system.Region
+		cachedLocation.Location.Constellation = system.Constellation
+		cachedLocation.Location.SolarSystem = system.SolarSystem
+	}
+
+	cachedLocation.Location.Station = &station
+	return cachedLocation
+}
+
+// Apply an override to a structure missing in the structure feed. Overrides with a name and solar system keep the
+// structure alive (the stored version's other attributes are kept), others are only applied to the stored version.
+// Fails if there is nothing to apply the override to.
+func overrideUnfedStructure(stored CachedLocation, override *pb.StructureOverride, system *pb.Location, expireAt int64) (CachedLocation, bool) {
+	if override.Name == "" || system == nil {
+		if stored.ID == 0 || stored.Location.Station == nil {
+			return CachedLocation{}, false
+		}
+
+		cachedLocation := applyOverride(stored, override, system)
+		cachedLocation.ExpiresAt = expireAt
+		return cachedLocation, true
+	}
+
+	cachedLocation := CachedLocation{
+		ID:        override.StructureId,
+		ExpiresAt: expireAt,
+		Version:   cachedLocationVersion,
+		Location: pb.Location{
+			Station: &pb.Station{Id: override.StructureId},
+		},
+	}
+
+	if stored.Location.Station != nil {
+		cachedLocation.Location.Station = stored.Location.Station
+	}
+
+	// The station was copied by applyOverride, so it can be modified
+	cachedLocation = applyOverride(cachedLocation, override, system)
+	if !override.Suppressed {
+		cachedLocation.Location.Station.Status = pb.Station_LIVE
+		cachedLocation.Location.Station.RemovedAt = nil
+	}
+
+	return cachedLocation, true
+}
+
+// Apply a structure's override (if any) to a structure which was not resolved via the structure feed
+func applyStoredOverride(ctx context.Context, cachedLocation CachedLocation) (CachedLocation, error) {
+	var override *pb.StructureOverride
+	err := db.View(func(tx *bolt.Tx) error {
+		var err error
+		override, err = readOverride(tx, cachedLocation.ID)
+		return err
+	})
+	if err != nil || override == nil {
+		return cachedLocation, err
+	}
+
+	var system *pb.Location
+	if override.SolarSystemId != 0 {
+		cachedSystem, err := fetchSolarSystem(ctx, override.SolarSystemId)
+		if err != nil {
+			return CachedLocation{}, err
+		}
+
+		system = &cachedSystem.Location
+	}
+
+	return applyOverride(cachedLocation, override, system), nil
+}
+
+// Get the solar systems overrides move structures to, these must be resolved before ingesting the structure feed
+func overrideSystemIDs() ([]int64, error) {
+	var ids []int64
+
+	err := db.View(func(tx *bolt.Tx) error {
+		overrides, err := readOverrides(tx)
+		for _, override := range overrides {
+			if override.SolarSystemId != 0 {
+				ids = append(ids, override.SolarSystemId)
+			}
+		}
+
+		return err
+	})
+
+	return ids, err
+}
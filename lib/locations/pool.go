@@ -0,0 +1,71 @@
+package locations
+
+import "context"
+
+// Priority of a job submitted to the worker pool
+type priority int
+
+const (
+	// Jobs resolving locations for clients
+	interactivePriority priority = iota
+	// Jobs spawned by bulk updates such as structure ingestion
+	bulkPriority
+)
+
+// workerPool runs jobs on a fixed number of goroutines, keeping memory usage and upstream concurrency predictable.
+// Interactive jobs are preferred over bulk jobs, so a large bulk update can not starve client requests.
+type workerPool struct {
+	interactive chan func()
+	bulk        chan func()
+}
+
+// Create a pool and start its workers
+func newWorkerPool(size int) *workerPool {
+	pool := &workerPool{
+		interactive: make(chan func()),
+		bulk:        make(chan func()),
+	}
+
+	for i := 0; i < size; i++ {
+		go pool.work()
+	}
+
+	return pool
+}
+
+// Execute jobs until the end of time
+func (pool *workerPool) work() {
+	for {
+		// Take interactive jobs first...
+		select {
+		case job := <-pool.interactive:
+			job()
+			continue
+		default:
+		}
+
+		// ...otherwise wait for whatever comes next
+		select {
+		case job := <-pool.interactive:
+			job()
+		case job := <-pool.bulk:
+			job()
+		}
+	}
+}
+
+// Submit a job to the pool, blocks until a worker picked it up. Returns false if ctx is done before that, the job is
+// not executed then.
+func (pool *workerPool) submit(ctx context.Context, jobPriority priority, job func()) bool {
+	queue := pool.interactive
+	if jobPriority == bulkPriority {
+		queue = pool.bulk
+	}
+
+	select {
+	case queue <- job:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package locations
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// IDs of locations with a background refresh which was scheduled but has not finished yet
+var pendingRefreshes = make(map[int64]struct{})
+var pendingRefreshesMutex sync.Mutex
+
+// Refresh an expired location in background with low priority, at most one refresh per ID is pending at a time.
+func scheduleRefresh(id int64) {
+	pendingRefreshesMutex.Lock()
+	if _, ok := pendingRefreshes[id]; ok {
+		pendingRefreshesMutex.Unlock()
+		return
+	}
+	pendingRefreshes[id] = struct{}{}
+	pendingRefreshesMutex.Unlock()
+
+	metrics.Add(metricRefreshesScheduled, 1)
+	metrics.Add(metricRefreshesPending, 1)
+
+	// This is usually called from within a worker, do not block it while waiting for another one
+	go workers.submit(context.Background(), bulkPriority, func() {
+		_, err := updateLocationInCache(context.Background(), id)
+		if err != nil {
+			logrus.WithError(err).Debugf("Could not refresh location %d in background", id)
+			metrics.Add(metricRefreshesFailed, 1)
+		} else {
+			metrics.Add(metricRefreshesSucceeded, 1)
+		}
+
+		pendingRefreshesMutex.Lock()
+		delete(pendingRefreshes, id)
+		pendingRefreshesMutex.Unlock()
+
+		metrics.Add(metricRefreshesPending, -1)
+	})
+}
@@ -0,0 +1,308 @@
+package locations
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of search results returned by default and at most
+const defaultSearchLimit = 50
+const maxSearchLimit = 500
+
+// SearchLocations returns cached locations whose names match a query, best matches first
+func SearchLocations(context context.Context, request *pb.SearchLocationsRequest) (*pb.SearchLocationsResponse, error) {
+	query := normalizeName(request.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "Query must not be empty")
+	}
+
+	limit := int(request.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	kinds := make(map[pb.LocationKind]struct{})
+	for _, kind := range request.GetKinds() {
+		kinds[kind] = struct{}{}
+	}
+
+	wormholeClasses := make(map[pb.SolarSystem_WormholeClass]struct{})
+	for _, class := range request.GetWormholeClasses() {
+		wormholeClasses[class] = struct{}{}
+	}
+
+	wormholeEffects := make(map[pb.SolarSystem_WormholeEffect]struct{})
+	for _, effect := range request.GetWormholeEffects() {
+		wormholeEffects[effect] = struct{}{}
+	}
+
+	matches := searchIndex.search(query, request.GetFuzzy(), func(entry searchEntry) bool {
+		if _, ok := kinds[entry.kind]; len(kinds) > 0 && !ok {
+			return false
+		}
+
+		if entry.hidden && !request.GetIncludeRemoved() {
+			return false
+		}
+
+		if _, ok := wormholeClasses[entry.wormholeClass]; len(wormholeClasses) > 0 && (!ok || !entry.inSystem) {
+			return false
+		}
+
+		if _, ok := wormholeEffects[entry.wormholeEffect]; len(wormholeEffects) > 0 && (!ok || !entry.inSystem) {
+			return false
+		}
+
+		return request.GetRegionId() == 0 || entry.regionID == request.GetRegionId()
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	response := pb.SearchLocationsResponse{
+		Results: make([]*pb.SearchResult, len(matches)),
+	}
+
+	for i, match := range matches {
+		response.Results[i] = &pb.SearchResult{
+			Id:       match.id,
+			Name:     match.name,
+			Kind:     match.kind,
+			RegionId: match.regionID,
+		}
+	}
+
+	return &response, nil
+}
+
+// Index of all cached locations' names
+var searchIndex = newNameIndex()
+
+// A location in the name index
+type searchEntry struct {
+	id             int64
+	name           string
+	normalizedName string
+	kind           pb.LocationKind
+	regionID       int64
+	// Set for solar systems and locations within them
+	inSystem       bool
+	wormholeClass  pb.SolarSystem_WormholeClass
+	wormholeEffect pb.SolarSystem_WormholeEffect
+	// Set for structures which disappeared from the feed or were suppressed
+	hidden bool
+}
+
+// A search hit, lower scores are better
+type searchMatch struct {
+	searchEntry
+	score int
+}
+
+// nameIndex holds the names of cached locations, entries are added as locations are put into cache.
+type nameIndex struct {
+	mutex   sync.RWMutex
+	entries map[int64]searchEntry
+}
+
+func newNameIndex() *nameIndex {
+	return &nameIndex{entries: make(map[int64]searchEntry)}
+}
+
+// Add or replace a location
+func (index *nameIndex) add(location CachedLocation) {
+	kind, name := describeLocation(&location.Location)
+	if kind == pb.LocationKind_ANY || name == "" {
+		return
+	}
+
+	entry := searchEntry{
+		id:             location.ID,
+		name:           name,
+		normalizedName: normalizeName(name),
+		kind:           kind,
+		hidden:         isHidden(&location.Location),
+	}
+
+	if location.Location.Region != nil {
+		entry.regionID = location.Location.Region.Id
+	}
+
+	if location.Location.SolarSystem != nil {
+		entry.inSystem = true
+		entry.wormholeClass = location.Location.SolarSystem.WormholeClass
+		entry.wormholeEffect = location.Location.SolarSystem.WormholeEffect
+	}
+
+	index.mutex.Lock()
+	index.entries[location.ID] = entry
+	index.mutex.Unlock()
+}
+
+// Find all entries matching a normalized query and filter. Prefix matches rank before matches within the name, fuzzy
+// matches (if enabled) rank last.
+func (index *nameIndex) search(query string, fuzzy bool, filter func(searchEntry) bool) []searchMatch {
+	var matches []searchMatch
+
+	index.mutex.RLock()
+	for _, entry := range index.entries {
+		score, ok := matchName(query, entry.normalizedName, fuzzy)
+		if !ok || !filter(entry) {
+			continue
+		}
+
+		matches = append(matches, searchMatch{searchEntry: entry, score: score})
+	}
+	index.mutex.RUnlock()
+
+	// Best score first, then shortest name (closest to the query), then alphabetically
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+
+		if len(matches[i].name) != len(matches[j].name) {
+			return len(matches[i].name) < len(matches[j].name)
+		}
+
+		return matches[i].name < matches[j].name
+	})
+
+	return matches
+}
+
+// Fill the in-memory indexes (search and spatial) with all locations stored in BoltDB
+func loadMemoryIndexes() {
+	var count int
+
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte("locations"))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		return bucket.ForEach(func(key []byte, value []byte) error {
+			var cachedLocation CachedLocation
+			err := cachedLocation.UnmarshalJSON(value)
+			if err != nil {
+				logrus.WithError(err).Warnf("Could not index location %s", key)
+				return nil
+			}
+
+			searchIndex.add(cachedLocation)
+			spatialIndex.add(cachedLocation)
+			count++
+			return nil
+		})
+	})
+
+	if err != nil {
+		logrus.WithError(err).Warn("Could not load in-memory indexes")
+		return
+	}
+
+	logrus.Infof("Indexed %d locations in memory.", count)
+}
+
+// Score how well a normalized name matches a normalized query
+func matchName(query string, name string, fuzzy bool) (int, bool) {
+	if strings.HasPrefix(name, query) {
+		return 0, true
+	}
+
+	if strings.Contains(name, query) {
+		return 1, true
+	}
+
+	if !fuzzy {
+		return 0, false
+	}
+
+	// Compare query with the name's beginning, allow one typo per four characters
+	queryRunes := []rune(query)
+	nameRunes := []rune(name)
+	if len(nameRunes) > len(queryRunes) {
+		nameRunes = nameRunes[:len(queryRunes)]
+	}
+
+	maxDistance := len(queryRunes) / 4
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	distance := levenshtein(queryRunes, nameRunes)
+	if distance > maxDistance {
+		return 0, false
+	}
+
+	return 1 + distance, true
+}
+
+// Calculate the edit distance between two strings
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, minInt(current[j-1]+1, previous[j-1]+cost))
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// Normalize a name for matching
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Get the kind and name of a location's most specific level, which is the location itself
+func describeLocation(location *pb.Location) (pb.LocationKind, string) {
+	switch {
+	case location.Celestial != nil:
+		return pb.LocationKind_CELESTIAL, location.Celestial.Name
+	case location.Station != nil && location.Station.Id > 1000000000000:
+		return pb.LocationKind_STRUCTURE, location.Station.Name
+	case location.Station != nil:
+		return pb.LocationKind_STATION, location.Station.Name
+	case location.SolarSystem != nil:
+		return pb.LocationKind_SOLAR_SYSTEM, location.SolarSystem.Name
+	case location.Constellation != nil:
+		return pb.LocationKind_CONSTELLATION, location.Constellation.Name
+	case location.Region != nil:
+		return pb.LocationKind_REGION, location.Region.Name
+	default:
+		return pb.LocationKind_ANY, ""
+	}
+}
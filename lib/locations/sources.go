@@ -0,0 +1,173 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/pkg/errors"
+)
+
+// LocationSource resolves the locations of a range of IDs from an upstream API.
+type LocationSource interface {
+	// Name of the source, used for logging
+	Name() string
+	// Claims reports whether the source is responsible for an ID
+	Claims(id int64) bool
+	// Fetch gets a single location from upstream, upstream requests are aborted once ctx is done
+	Fetch(ctx context.Context, id int64) (CachedLocation, error)
+	// Refresh updates the source's locations in bulk, it is called periodically
+	Refresh()
+	// RefreshOnAccess reports whether expired entries are fetched again when queried, sources which are only kept up
+	// to date via Refresh return false
+	RefreshOnAccess(id int64) bool
+	// TTL is the expiry of fetched entries in case upstream does not provide one
+	TTL(id int64) time.Duration
+}
+
+// Registered sources, the first one claiming an ID is responsible for it
+var locationSources = []LocationSource{
+	esiUniverseSource{},
+	esiStationSource{},
+	esiCelestialSource{},
+	structureFeedSource{},
+}
+var locationSourcesMutex sync.RWMutex
+
+// RegisterSource adds a source of locations. Registered sources take precedence over built-in ones and should be
+// registered before calling Initialize.
+func RegisterSource(source LocationSource) {
+	locationSourcesMutex.Lock()
+	defer locationSourcesMutex.Unlock()
+
+	locationSources = append([]LocationSource{source}, locationSources...)
+}
+
+// Get the source responsible for an ID, nil if no source claims it.
+func sourceFor(id int64) LocationSource {
+	locationSourcesMutex.RLock()
+	defer locationSourcesMutex.RUnlock()
+
+	for _, source := range locationSources {
+		if source.Claims(id) {
+			return source
+		}
+	}
+
+	return nil
+}
+
+// Get a copy of all registered sources
+func allSources() []LocationSource {
+	locationSourcesMutex.RLock()
+	defer locationSourcesMutex.RUnlock()
+
+	return append([]LocationSource(nil), locationSources...)
+}
+
+// Check whether expired entries of an ID are fetched again when queried
+func refreshesOnAccess(id int64) bool {
+	source := sourceFor(id)
+	return source != nil && source.RefreshOnAccess(id)
+}
+
+// Get the fallback expiry of an ID
+func sourceTTL(id int64) time.Duration {
+	source := sourceFor(id)
+	if source == nil {
+		return defaultTTL
+	}
+
+	return source.TTL(id)
+}
+
+// Regions, constellations and solar systems from ESI's universe API. Regions are refreshed by the crawler, or in bulk
+// if the crawler is disabled.
+type esiUniverseSource struct{}
+
+func (esiUniverseSource) Name() string {
+	return "esi-universe"
+}
+
+func (esiUniverseSource) Claims(id int64) bool {
+	return id >= 10000000 && id < 40000000
+}
+
+func (esiUniverseSource) Fetch(ctx context.Context, id int64) (CachedLocation, error) {
+	return fetchLocationFromESI(ctx, id)
+}
+
+func (esiUniverseSource) Refresh() {
+	if !crawlerEnabled {
+		updateRegions()
+	}
+}
+
+func (esiUniverseSource) RefreshOnAccess(id int64) bool {
+	return id > 20000000
+}
+
+func (esiUniverseSource) TTL(id int64) time.Duration {
+	return defaultTTL
+}
+
+// NPC and conquerable stations from ESI's universe API.
+type esiStationSource struct{}
+
+func (esiStationSource) Name() string {
+	return "esi-station"
+}
+
+func (esiStationSource) Claims(id int64) bool {
+	return id >= 60000000 && id <= 64000000
+}
+
+func (esiStationSource) Fetch(ctx context.Context, id int64) (CachedLocation, error) {
+	return fetchLocationFromESI(ctx, id)
+}
+
+func (esiStationSource) Refresh() {}
+
+func (esiStationSource) RefreshOnAccess(id int64) bool {
+	return true
+}
+
+func (esiStationSource) TTL(id int64) time.Duration {
+	if id > 61000000 {
+		return conquerableStationTTL
+	}
+
+	return defaultTTL
+}
+
+// Structures (citadels...) from the 3rd party structure API, which is only fetched in bulk.
+type structureFeedSource struct{}
+
+func (structureFeedSource) Name() string {
+	return "structure-feed"
+}
+
+func (structureFeedSource) Claims(id int64) bool {
+	return id > 1000000000000
+}
+
+func (structureFeedSource) Fetch(ctx context.Context, id int64) (CachedLocation, error) {
+	// This only happens if someone queries a citadel which is unknown
+	msg := fmt.Sprintf("Could not find citadel %d in current dataset", id)
+	return CachedLocation{}, &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN_STRUCTURE, Err: errors.New(msg)}
+}
+
+func (structureFeedSource) Refresh() {
+	updateStructures()
+}
+
+func (structureFeedSource) RefreshOnAccess(id int64) bool {
+	return false
+}
+
+func (structureFeedSource) TTL(id int64) time.Duration {
+	// Expiry has no effect, structures are refreshed in bulk
+	return defaultTTL
+}
@@ -0,0 +1,98 @@
+package locations
+
+import (
+	"context"
+	"sync"
+
+	"github.com/EVE-Tools/static-data/lib/navigation"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// GetStargateGraph returns all solar systems and their stargate connections, built from the cached solar systems and
+// stargates. Systems are resolved on the worker pool with bulk priority, expired entries are refreshed using
+// conditional requests. Also returns the IDs of systems which could not be resolved.
+func GetStargateGraph(ctx context.Context) ([]navigation.SystemNode, []int64, error) {
+	systemIDs, err := getSystemIDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var systems []navigation.SystemNode
+	var failed []int64
+	var mutex sync.Mutex
+
+	var wg sync.WaitGroup
+	for _, id := range toInt64s(systemIDs) {
+		id := id
+		wg.Add(1)
+
+		submitted := workers.submit(ctx, bulkPriority, func() {
+			defer wg.Done()
+
+			system, err := getSystemNode(ctx, id)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				logrus.WithError(err).Warnf("Could not get stargates of solar system %d", id)
+				failed = append(failed, id)
+				return
+			}
+
+			systems = append(systems, system)
+		})
+		if !submitted {
+			wg.Done()
+			mutex.Lock()
+			failed = append(failed, id)
+			mutex.Unlock()
+		}
+	}
+
+	wg.Wait()
+
+	return systems, failed, nil
+}
+
+// Get a solar system's node in the stargate graph from cache, the destinations of its stargates are its neighbours
+func getSystemNode(ctx context.Context, id int64) (navigation.SystemNode, error) {
+	// Category is known from the list of systems, no need to ask ESI
+	setLocationCategory(id, "solar_system")
+
+	cachedSystem, err := getCachedLocation(ctx, id)
+	if err != nil {
+		return navigation.SystemNode{}, err
+	}
+
+	solarSystem := cachedSystem.Location.SolarSystem
+	if solarSystem == nil {
+		return navigation.SystemNode{}, errors.Errorf("location %d is not a solar system", id)
+	}
+
+	system := navigation.SystemNode{
+		ID:             solarSystem.Id,
+		SecurityStatus: solarSystem.SecurityStatus,
+		Neighbours:     make([]int64, 0, len(solarSystem.StargateIds)),
+		X:              solarSystem.Coordinates.GetX(),
+		Y:              solarSystem.Coordinates.GetY(),
+		Z:              solarSystem.Coordinates.GetZ(),
+		RegionID:       cachedSystem.Location.Region.GetId(),
+	}
+
+	for _, stargateID := range solarSystem.StargateIds {
+		stargate, err := getCachedLocation(ctx, stargateID)
+		if err != nil {
+			return navigation.SystemNode{}, errors.Wrapf(err, "could not get stargate %d", stargateID)
+		}
+
+		if stargate.Location.Celestial.GetDestinationSystemId() == 0 {
+			return navigation.SystemNode{}, errors.Errorf("location %d is not a stargate", stargateID)
+		}
+
+		system.Neighbours = append(system.Neighbours, stargate.Location.Celestial.GetDestinationSystemId())
+	}
+
+	return system, nil
+}
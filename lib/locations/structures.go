@@ -0,0 +1,244 @@
+package locations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/antihax/goesi"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Expiry of structures resolved via ESI if it does not send an Expires header
+const structureTTL = time.Hour
+
+// Structures ESI denied access to are not requested again until this expires
+const forbiddenStructureTTL = 24 * time.Hour
+
+// Maps IDs of structures ESI denied access to onto the denial's expiry
+const forbiddenStructuresBucket = "forbiddenStructures"
+
+// Scope required for resolving structures
+const structureScope = "esi-universe.read_structures.v1"
+
+// StructureAuth holds the SSO credentials used for resolving structures via ESI
+type StructureAuth struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	// SSO's token endpoint, the default endpoint is used if empty
+	TokenURL string
+}
+
+// Structures (citadels...) from ESI's authenticated structure API, used for structures missing in the structure feed.
+// Only structures the token's character has access to can be resolved.
+type esiStructureSource struct {
+	tokenSource goesi.CRESTTokenSource
+}
+
+// Create a structure source authenticated via SSO
+func newESIStructureSource(client *http.Client, auth StructureAuth) (esiStructureSource, error) {
+	authenticator := goesi.NewSSOAuthenticator(client, auth.ClientID, auth.ClientSecret, "", []string{structureScope})
+	if auth.TokenURL != "" {
+		authenticator.ChangeTokenURL(auth.TokenURL)
+	}
+
+	tokenSource, err := authenticator.TokenSource(&goesi.CRESTToken{RefreshToken: auth.RefreshToken})
+	if err != nil {
+		return esiStructureSource{}, errors.Wrap(err, "could not create SSO token source")
+	}
+
+	return esiStructureSource{tokenSource: tokenSource}, nil
+}
+
+func (esiStructureSource) Name() string {
+	return "esi-structure"
+}
+
+// Structures known from the feed or carrying an override are left to the structure feed
+func (esiStructureSource) Claims(id int64) bool {
+	return id > 1000000000000 && !isFedStructure(id)
+}
+
+func (source esiStructureSource) Fetch(ctx context.Context, id int64) (CachedLocation, error) {
+	return coalesceFetch(ctx, id, source.load)
+}
+
+func (esiStructureSource) Refresh() {}
+
+func (esiStructureSource) RefreshOnAccess(id int64) bool {
+	return true
+}
+
+func (esiStructureSource) TTL(id int64) time.Duration {
+	return structureTTL
+}
+
+// Load a structure from cache or ESI, use Fetch instead of calling this.
+func (source esiStructureSource) load(ctx context.Context, id int64) (CachedLocation, error) {
+	cachedStructure, needsUpdate, err := fetchLocationFromCache(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	if !needsUpdate {
+		return cachedStructure, nil
+	}
+
+	// Structures which disappeared from the feed keep their status, they are never resolved via ESI
+	if isHidden(&cachedStructure.Location) {
+		return cachedStructure, nil
+	}
+
+	forbidden, err := isStructureForbidden(id)
+	if err != nil {
+		return CachedLocation{}, err
+	}
+	if forbidden {
+		return CachedLocation{}, forbiddenStructureError(id)
+	}
+
+	logrus.WithField("structure_id", id).Debug("Loading structure from ESI.")
+
+	authCtx := context.WithValue(conditionalContext(ctx, cachedStructure), goesi.ContextOAuth2, source.tokenSource)
+	structure, response, err := esiClient.ESI.UniverseApi.GetUniverseStructuresStructureId(authCtx, id, nil)
+	if httpcache.NotModified(response) {
+		return extendExpiry(cachedStructure, response, structureTTL), nil
+	}
+	if response != nil && response.StatusCode == http.StatusForbidden {
+		err = forbidStructure(id, time.Now().Add(forbiddenStructureTTL))
+		if err != nil {
+			logrus.WithError(err).Warnf("Could not remember denied structure %d", id)
+		}
+
+		return CachedLocation{}, forbiddenStructureError(id)
+	}
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		msg := fmt.Sprintf("Structure %d does not exist", id)
+		return CachedLocation{}, &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN_STRUCTURE, Err: errors.New(msg)}
+	}
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	solarSystem, err := fetchSolarSystem(ctx, int64(structure.SolarSystemId))
+	if err != nil {
+		return CachedLocation{}, err
+	}
+
+	// The owner's name is filled on the next refresh if it can't be resolved right now
+	ownerName, err := getCorporationName(ctx, int64(structure.OwnerId), structureTTL)
+	if err != nil {
+		logrus.WithError(err).Warnf("Could not resolve owner of structure %d", id)
+	}
+
+	now := ptypes.TimestampNow()
+	station := &pb.Station{
+		Id:        id,
+		Name:      structure.Name,
+		TypeId:    int64(structure.TypeId),
+		LastSeen:  now,
+		FirstSeen: now,
+		Coordinates: &pb.Coordinates{
+			X: structure.Position.X,
+			Y: structure.Position.Y,
+			Z: structure.Position.Z,
+		},
+		OwnerId:   int64(structure.OwnerId),
+		OwnerName: ownerName,
+		Status:    pb.Station_LIVE,
+	}
+
+	// Keep what the structure feed knows about the structure, ESI does not return it
+	if cachedStation := cachedStructure.Location.Station; cachedStation != nil {
+		station.TypeName = cachedStation.TypeName
+		station.Public = cachedStation.Public
+		if cachedStation.FirstSeen != nil {
+			station.FirstSeen = cachedStation.FirstSeen
+		}
+	}
+
+	location := pb.Location(solarSystem.Location)
+	location.Station = station
+
+	return applyStoredOverride(ctx, newCachedLocation(id, location, response, structureTTL))
+}
+
+// Check whether a structure is part of the last structure feed or has an override
+func isFedStructure(id int64) bool {
+	var fed bool
+
+	err := db.View(func(tx *bolt.Tx) error {
+		key := []byte(strconv.FormatInt(id, 10))
+		for _, bucketName := range []string{feedStructuresBucket, structureOverridesBucket} {
+			bucket := tx.Bucket([]byte(bucketName))
+			if bucket == nil {
+				panic("Bucket not found! This should never happen!")
+			}
+
+			if bucket.Get(key) != nil {
+				fed = true
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Warnf("Could not check whether structure %d is part of the feed", id)
+	}
+
+	return fed
+}
+
+// Error returned for structures ESI denied access to
+func forbiddenStructureError(id int64) error {
+	msg := fmt.Sprintf("Access to structure %d denied by ESI", id)
+	return &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN_STRUCTURE, Err: errors.New(msg)}
+}
+
+// Check whether ESI recently denied access to a structure
+func isStructureForbidden(id int64) (bool, error) {
+	var forbidden bool
+
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(forbiddenStructuresBucket))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		value := bucket.Get([]byte(strconv.FormatInt(id, 10)))
+		if value == nil {
+			return nil
+		}
+
+		expiresAt, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		forbidden = expiresAt > time.Now().Unix()
+		return nil
+	})
+
+	return forbidden, err
+}
+
+// Remember that ESI denied access to a structure until expiresAt
+func forbidStructure(id int64, expiresAt time.Time) error {
+	return db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(forbiddenStructuresBucket))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		return bucket.Put([]byte(strconv.FormatInt(id, 10)), []byte(strconv.FormatInt(expiresAt.Unix(), 10)))
+	})
+}
@@ -0,0 +1,388 @@
+package locations
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EVE-Tools/static-data/lib/httpcache"
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/antihax/goesi"
+	"github.com/boltdb/bolt"
+)
+
+const (
+	testStructureID   = 1022734985679
+	testSolarSystemID = 30000142
+	testOwnerID       = 98000001
+	testRefreshToken  = "refresh-token"
+	testAccessToken   = "access-token"
+)
+
+// Expires header sent by the fake ESI, rounded to seconds as HTTP dates have no sub-second precision
+var testExpires = time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+// fakeUpstream emulates SSO's token endpoint and the parts of ESI used for resolving structures
+type fakeUpstream struct {
+	mutex sync.Mutex
+	// Status the structure endpoint answers with
+	structureStatus int
+	// Requests received by the structure endpoint
+	structureRequests int
+	authorization     string
+	ifNoneMatch       string
+	// Refresh tokens received by the token endpoint
+	refreshTokens []string
+}
+
+func (upstream *fakeUpstream) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	upstream.mutex.Lock()
+	defer upstream.mutex.Unlock()
+
+	switch {
+	case request.URL.Path == "/token":
+		request.ParseForm()
+		if request.Form.Get("grant_type") != "refresh_token" {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		upstream.refreshTokens = append(upstream.refreshTokens, request.Form.Get("refresh_token"))
+		writeJSON(writer, map[string]interface{}{
+			"access_token":  testAccessToken,
+			"token_type":    "Bearer",
+			"expires_in":    1200,
+			"refresh_token": testRefreshToken,
+		})
+	case strings.Contains(request.URL.Path, "/universe/structures/"):
+		upstream.structureRequests++
+		upstream.authorization = request.Header.Get("Authorization")
+		upstream.ifNoneMatch = request.Header.Get("If-None-Match")
+
+		writer.Header().Set("Expires", testExpires.UTC().Format(http.TimeFormat))
+		writer.Header().Set("ETag", `"new-etag"`)
+
+		if upstream.structureStatus != http.StatusOK {
+			writer.WriteHeader(upstream.structureStatus)
+			return
+		}
+
+		writeJSON(writer, map[string]interface{}{
+			"name":            "Jita - Test Fortizar",
+			"owner_id":        testOwnerID,
+			"solar_system_id": testSolarSystemID,
+			"type_id":         35833,
+			"position":        map[string]float64{"x": 1, "y": 2, "z": 3},
+		})
+	case strings.Contains(request.URL.Path, "/universe/names/"):
+		writeJSON(writer, []map[string]interface{}{
+			{"id": testOwnerID, "name": "Test Corporation", "category": "corporation"},
+		})
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJSON(writer http.ResponseWriter, value interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(value)
+}
+
+// Point the package at a fresh database and ESI at the given handler, returns the fake server and a cleanup function
+func setupTestCache(t *testing.T, upstream http.Handler) (*httptest.Server, func()) {
+	server := httptest.NewServer(upstream)
+
+	dir, err := ioutil.TempDir("", "static-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = bolt.Open(filepath.Join(dir, "test.db"), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Update(initializeBuckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hotCache = newLocationLRU(100)
+	esiClient = goesi.NewAPIClient(&http.Client{Transport: httpcache.NewTransport(http.DefaultTransport)}, "test")
+	esiClient.ChangeBasePath(server.URL)
+
+	return server, func() {
+		db.Close()
+		os.RemoveAll(dir)
+		server.Close()
+	}
+}
+
+// Point the package at a fresh database and the fake upstream, returns the structure source and a cleanup function
+func setupStructureSource(t *testing.T, upstream *fakeUpstream) (esiStructureSource, func()) {
+	server, cleanupCache := setupTestCache(t, upstream)
+
+	source, err := newESIStructureSource(server.Client(), StructureAuth{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RefreshToken: testRefreshToken,
+		TokenURL:     server.URL + "/token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previousSources := allSources()
+	RegisterSource(source)
+
+	// The structure's solar system is cached, so it is not requested from ESI
+	err = putIntoCache(CachedLocation{
+		ID:        testSolarSystemID,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Version:   cachedLocationVersion,
+		Location: pb.Location{
+			Region:        &pb.Region{Id: 10000002, Name: "The Forge"},
+			Constellation: &pb.Constellation{Id: 20000020, Name: "Kimotoro"},
+			SolarSystem:   &pb.SolarSystem{Id: testSolarSystemID, Name: "Jita"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return source, func() {
+		locationSourcesMutex.Lock()
+		locationSources = previousSources
+		locationSourcesMutex.Unlock()
+
+		cleanupCache()
+	}
+}
+
+// Check that an error is a LocationError with the given reason
+func assertFailure(t *testing.T, err error, reason pb.LocationFailure_Reason) {
+	locationErr, ok := err.(*LocationError)
+	if !ok {
+		t.Fatalf("expected a LocationError, got %v", err)
+	}
+
+	if locationErr.Reason != reason {
+		t.Fatalf("expected reason %s, got %s", reason, locationErr.Reason)
+	}
+}
+
+func TestESIStructureSourceRefreshesToken(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusOK}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	_, err := source.Fetch(context.Background(), testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(upstream.refreshTokens) != 1 || upstream.refreshTokens[0] != testRefreshToken {
+		t.Errorf("expected a single refresh with %q, got %v", testRefreshToken, upstream.refreshTokens)
+	}
+
+	if upstream.authorization != "Bearer "+testAccessToken {
+		t.Errorf("expected ESI to be called with the refreshed token, got %q", upstream.authorization)
+	}
+}
+
+func TestESIStructureSourceResolvesStructure(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusOK}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	structure, err := source.Fetch(context.Background(), testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	station := structure.Location.Station
+	if station == nil {
+		t.Fatal("expected structure to be returned as station")
+	}
+
+	if station.Id != testStructureID || station.Name != "Jita - Test Fortizar" || station.TypeId != 35833 {
+		t.Errorf("unexpected station %v", station)
+	}
+
+	if station.OwnerId != testOwnerID || station.OwnerName != "Test Corporation" {
+		t.Errorf("unexpected owner %d %q", station.OwnerId, station.OwnerName)
+	}
+
+	if station.Coordinates == nil || station.Coordinates.X != 1 || station.Coordinates.Y != 2 || station.Coordinates.Z != 3 {
+		t.Errorf("unexpected coordinates %v", station.Coordinates)
+	}
+
+	if station.Status != pb.Station_LIVE {
+		t.Errorf("expected structure to be live, got %s", station.Status)
+	}
+
+	if structure.Location.SolarSystem.GetId() != testSolarSystemID || structure.Location.Region.GetId() != 10000002 {
+		t.Errorf("expected structure to be placed in its solar system, got %v", structure.Location)
+	}
+
+	if structure.ExpiresAt != testExpires.Unix() || structure.ETag != `"new-etag"` {
+		t.Errorf("expected expiry and ETag from headers, got %d %q", structure.ExpiresAt, structure.ETag)
+	}
+}
+
+func TestESIStructureSourceSkipsForbiddenStructure(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusForbidden}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	_, err := source.Fetch(context.Background(), testStructureID)
+	assertFailure(t, err, pb.LocationFailure_UNKNOWN_STRUCTURE)
+
+	forbidden, err := isStructureForbidden(testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !forbidden {
+		t.Fatal("expected denial to be stored")
+	}
+
+	// Denied structures are not requested again...
+	_, err = source.Fetch(context.Background(), testStructureID)
+	assertFailure(t, err, pb.LocationFailure_UNKNOWN_STRUCTURE)
+	if upstream.structureRequests != 1 {
+		t.Fatalf("expected denied structure not to be requested again, got %d requests", upstream.structureRequests)
+	}
+
+	// ...until the denial expired
+	err = forbidStructure(testStructureID, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = source.Fetch(context.Background(), testStructureID)
+	assertFailure(t, err, pb.LocationFailure_UNKNOWN_STRUCTURE)
+	if upstream.structureRequests != 2 {
+		t.Fatalf("expected structure to be requested again after denial expired, got %d requests", upstream.structureRequests)
+	}
+}
+
+func TestESIStructureSourceReportsUnknownStructure(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusNotFound}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	_, err := source.Fetch(context.Background(), testStructureID)
+	assertFailure(t, err, pb.LocationFailure_UNKNOWN_STRUCTURE)
+
+	forbidden, err := isStructureForbidden(testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forbidden {
+		t.Error("expected unknown structure not to be stored as denied")
+	}
+}
+
+func TestESIStructureSourceExtendsExpiryIfNotModified(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusNotModified}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	cached := CachedLocation{
+		ID:        testStructureID,
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+		ETag:      `"old-etag"`,
+		Version:   cachedLocationVersion,
+		Location: pb.Location{
+			SolarSystem: &pb.SolarSystem{Id: testSolarSystemID, Name: "Jita"},
+			Station:     &pb.Station{Id: testStructureID, Name: "Cached Fortizar", Status: pb.Station_LIVE},
+		},
+	}
+	err := putIntoCache(cached)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	structure, err := source.Fetch(context.Background(), testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if upstream.ifNoneMatch != `"old-etag"` {
+		t.Errorf("expected conditional request with cached ETag, got %q", upstream.ifNoneMatch)
+	}
+
+	if structure.ExpiresAt != testExpires.Unix() || structure.ETag != `"new-etag"` {
+		t.Errorf("expected expiry and ETag to be taken from headers, got %d %q", structure.ExpiresAt, structure.ETag)
+	}
+
+	if structure.Location.Station.Name != "Cached Fortizar" {
+		t.Errorf("expected cached structure to be kept, got %v", structure.Location.Station)
+	}
+}
+
+func TestESIStructureSourceLeavesFeedStructuresToFeed(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusOK}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	if !source.Claims(testStructureID) {
+		t.Fatal("expected structure missing in the feed to be claimed")
+	}
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(feedStructuresBucket)).Put([]byte(strconv.FormatInt(testStructureID, 10)), []byte{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if source.Claims(testStructureID) {
+		t.Error("expected structure of the feed not to be claimed")
+	}
+
+	if _, ok := sourceFor(testStructureID).(structureFeedSource); !ok {
+		t.Errorf("expected structure feed to be responsible, got %s", sourceFor(testStructureID).Name())
+	}
+}
+
+func TestESIStructureSourceKeepsRemovedStructure(t *testing.T) {
+	upstream := &fakeUpstream{structureStatus: http.StatusOK}
+	source, cleanup := setupStructureSource(t, upstream)
+	defer cleanup()
+
+	err := putIntoCache(CachedLocation{
+		ID:        testStructureID,
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+		Version:   cachedLocationVersion,
+		Location: pb.Location{
+			SolarSystem: &pb.SolarSystem{Id: testSolarSystemID, Name: "Jita"},
+			Station:     &pb.Station{Id: testStructureID, Name: "Removed Fortizar", Status: pb.Station_REMOVED},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	structure, err := source.Fetch(context.Background(), testStructureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if structure.Location.Station.Status != pb.Station_REMOVED {
+		t.Errorf("expected structure to stay removed, got %s", structure.Location.Station.Status)
+	}
+
+	if upstream.structureRequests != 0 {
+		t.Errorf("expected removed structure not to be requested, got %d requests", upstream.structureRequests)
+	}
+}
@@ -15,8 +15,19 @@ type CachedLocation struct {
 	ID        int64       `json:"id"`
 	ExpiresAt int64       `json:"expiresAt"`
 	Location  pb.Location `json:"location"`
+	// ETag of the upstream resource, used for conditional requests
+	ETag string `json:"etag,omitempty"`
+	// Schema version of the entry, outdated entries are fetched again
+	Version int `json:"version"`
+	// IDs of the location's children (constellations of a region, systems of a constellation, stations of a system)
+	Children []int64 `json:"children,omitempty"`
+	// Set if the entry expired and could not be refreshed, not persisted
+	Stale bool `json:"-"`
 }
 
+// Version of CachedLocation's schema, bump when adding fields to locations so existing entries migrate forward
+const cachedLocationVersion = 8
+
 //
 // 3rd party structures API
 //
@@ -39,3 +50,30 @@ type Structure struct {
 	FirstSeen   time.Time      `json:"firstSeen"`
 	RegionName  string         `json:"regionName"`
 }
+
+// StructureSnapshot stores a structure's attributes at the time they were first observed.
+//easyjson:json
+type StructureSnapshot struct {
+	ObservedAt    int64             `json:"observed_at"`
+	Name          string            `json:"name"`
+	TypeID        int64             `json:"type_id"`
+	TypeName      string            `json:"type_name"`
+	Public        bool              `json:"public"`
+	SolarSystemID int64             `json:"solar_system_id"`
+	Status        pb.Station_Status `json:"status"`
+}
+
+// StoredOverride stores an admin's override of a structure, its audit trail is stored separately.
+//easyjson:json
+type StoredOverride struct {
+	Override pb.StructureOverride `json:"override"`
+}
+
+// OverrideAuditEntry stores a single change of a structure's override.
+//easyjson:json
+type OverrideAuditEntry struct {
+	Author    string                       `json:"author"`
+	Reason    string                       `json:"reason"`
+	ChangedAt int64                        `json:"changed_at"`
+	Action    pb.OverrideAuditEntry_Action `json:"action"`
+}
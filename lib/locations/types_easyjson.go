@@ -19,7 +19,150 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(in *jlexer.Lexer, out *Structure) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(in *jlexer.Lexer, out *StructureSnapshot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "observed_at":
+			out.ObservedAt = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "type_id":
+			out.TypeID = int64(in.Int64())
+		case "type_name":
+			out.TypeName = string(in.String())
+		case "public":
+			out.Public = bool(in.Bool())
+		case "solar_system_id":
+			out.SolarSystemID = int64(in.Int64())
+		case "status":
+			out.Status = staticData.Station_Status(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(out *jwriter.Writer, in StructureSnapshot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"observed_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ObservedAt))
+	}
+	{
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.TypeID))
+	}
+	{
+		const prefix string = ",\"type_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TypeName))
+	}
+	{
+		const prefix string = ",\"public\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Public))
+	}
+	{
+		const prefix string = ",\"solar_system_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.SolarSystemID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StructureSnapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StructureSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StructureSnapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StructureSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(l, v)
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(in *jlexer.Lexer, out *Structure) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -74,7 +217,7 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(out *jwriter.Writer, in Structure) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(out *jwriter.Writer, in Structure) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -194,25 +337,25 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(out *jwriter.
 // MarshalJSON supports json.Marshaler interface
 func (v Structure) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(&w, v)
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Structure) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations(w, v)
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Structure) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(&r, v)
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Structure) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations(l, v)
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(l, v)
 }
 func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in *jlexer.Lexer, out *staticData.Coordinates) {
 	isTopLevel := in.IsStart()
@@ -285,7 +428,7 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out *jwriter
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(in *jlexer.Lexer, out *CachedLocation) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(in *jlexer.Lexer, out *StoredOverride) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -304,12 +447,8 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "expiresAt":
-			out.ExpiresAt = int64(in.Int64())
-		case "location":
-			easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in, &out.Location)
+		case "override":
+			easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in, &out.Override)
 		default:
 			in.SkipRecursive()
 		}
@@ -320,67 +459,47 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(out *jwriter.Writer, in CachedLocation) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(out *jwriter.Writer, in StoredOverride) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"expiresAt\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int64(int64(in.ExpiresAt))
-	}
-	{
-		const prefix string = ",\"location\":"
+		const prefix string = ",\"override\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData1(out, in.Location)
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData1(out, in.Override)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CachedLocation) MarshalJSON() ([]byte, error) {
+func (v StoredOverride) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(&w, v)
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CachedLocation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations1(w, v)
+func (v StoredOverride) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CachedLocation) UnmarshalJSON(data []byte) error {
+func (v *StoredOverride) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(&r, v)
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CachedLocation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations1(l, v)
+func (v *StoredOverride) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(l, v)
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in *jlexer.Lexer, out *staticData.Location) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in *jlexer.Lexer, out *staticData.StructureOverride) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -399,45 +518,60 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in *jlexer.
 			continue
 		}
 		switch key {
-		case "region":
-			if in.IsNull() {
-				in.Skip()
-				out.Region = nil
-			} else {
-				if out.Region == nil {
-					out.Region = new(staticData.Region)
-				}
-				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in, &*out.Region)
-			}
-		case "constellation":
+		case "structure_id":
+			out.StructureId = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "type_id":
+			out.TypeId = int64(in.Int64())
+		case "type_name":
+			out.TypeName = string(in.String())
+		case "solar_system_id":
+			out.SolarSystemId = int64(in.Int64())
+		case "coordinates":
 			if in.IsNull() {
 				in.Skip()
-				out.Constellation = nil
+				out.Coordinates = nil
 			} else {
-				if out.Constellation == nil {
-					out.Constellation = new(staticData.Constellation)
+				if out.Coordinates == nil {
+					out.Coordinates = new(staticData.Coordinates)
 				}
-				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in, &*out.Constellation)
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in, &*out.Coordinates)
 			}
-		case "solar_system":
+		case "visibility":
+			out.Visibility = staticData.StructureOverride_Visibility(in.Int32())
+		case "suppressed":
+			out.Suppressed = bool(in.Bool())
+		case "audit_trail":
 			if in.IsNull() {
 				in.Skip()
-				out.SolarSystem = nil
+				out.AuditTrail = nil
 			} else {
-				if out.SolarSystem == nil {
-					out.SolarSystem = new(staticData.SolarSystem)
+				in.Delim('[')
+				if out.AuditTrail == nil {
+					if !in.IsDelim(']') {
+						out.AuditTrail = make([]*staticData.OverrideAuditEntry, 0, 8)
+					} else {
+						out.AuditTrail = []*staticData.OverrideAuditEntry{}
+					}
+				} else {
+					out.AuditTrail = (out.AuditTrail)[:0]
 				}
-				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in, &*out.SolarSystem)
-			}
-		case "station":
-			if in.IsNull() {
-				in.Skip()
-				out.Station = nil
-			} else {
-				if out.Station == nil {
-					out.Station = new(staticData.Station)
+				for !in.IsDelim(']') {
+					var v1 *staticData.OverrideAuditEntry
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(staticData.OverrideAuditEntry)
+						}
+						easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in, &*v1)
+					}
+					out.AuditTrail = append(out.AuditTrail, v1)
+					in.WantComma()
 				}
-				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in, &*out.Station)
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -448,54 +582,797 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData1(in *jlexer.
 	if isTopLevel {
 		in.Consumed()
 	}
-}
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData1(out *jwriter.Writer, in staticData.Location) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.Region != nil {
-		const prefix string = ",\"region\":"
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData1(out *jwriter.Writer, in staticData.StructureOverride) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.StructureId != 0 {
+		const prefix string = ",\"structure_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.StructureId))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.TypeId != 0 {
+		const prefix string = ",\"type_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.TypeId))
+	}
+	if in.TypeName != "" {
+		const prefix string = ",\"type_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TypeName))
+	}
+	if in.SolarSystemId != 0 {
+		const prefix string = ",\"solar_system_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.SolarSystemId))
+	}
+	if in.Coordinates != nil {
+		const prefix string = ",\"coordinates\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out, *in.Coordinates)
+	}
+	if in.Visibility != 0 {
+		const prefix string = ",\"visibility\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Visibility))
+	}
+	if in.Suppressed {
+		const prefix string = ",\"suppressed\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Suppressed))
+	}
+	if len(in.AuditTrail) != 0 {
+		const prefix string = ",\"audit_trail\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.AuditTrail {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData2(out, *v3)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in *jlexer.Lexer, out *staticData.OverrideAuditEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "author":
+			out.Author = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "changed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ChangedAt = nil
+			} else {
+				if out.ChangedAt == nil {
+					out.ChangedAt = new(timestamp.Timestamp)
+				}
+				easyjson6601e8cdDecodeGithubComGolangProtobufPtypesTimestamp(in, &*out.ChangedAt)
+			}
+		case "action":
+			out.Action = staticData.OverrideAuditEntry_Action(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData2(out *jwriter.Writer, in staticData.OverrideAuditEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Author != "" {
+		const prefix string = ",\"author\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Author))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Reason))
+	}
+	if in.ChangedAt != nil {
+		const prefix string = ",\"changed_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComGolangProtobufPtypesTimestamp(out, *in.ChangedAt)
+	}
+	if in.Action != 0 {
+		const prefix string = ",\"action\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Action))
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComGolangProtobufPtypesTimestamp(in *jlexer.Lexer, out *timestamp.Timestamp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "seconds":
+			out.Seconds = int64(in.Int64())
+		case "nanos":
+			out.Nanos = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComGolangProtobufPtypesTimestamp(out *jwriter.Writer, in timestamp.Timestamp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Seconds != 0 {
+		const prefix string = ",\"seconds\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Seconds))
+	}
+	if in.Nanos != 0 {
+		const prefix string = ",\"nanos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Nanos))
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations3(in *jlexer.Lexer, out *OverrideAuditEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "author":
+			out.Author = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "changed_at":
+			out.ChangedAt = int64(in.Int64())
+		case "action":
+			out.Action = staticData.OverrideAuditEntry_Action(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations3(out *jwriter.Writer, in OverrideAuditEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"author\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"changed_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ChangedAt))
+	}
+	{
+		const prefix string = ",\"action\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Action))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OverrideAuditEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OverrideAuditEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OverrideAuditEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OverrideAuditEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations3(l, v)
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations4(in *jlexer.Lexer, out *CachedLocation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "expiresAt":
+			out.ExpiresAt = int64(in.Int64())
+		case "location":
+			easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in, &out.Location)
+		case "etag":
+			out.ETag = string(in.String())
+		case "version":
+			out.Version = int(in.Int())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]int64, 0, 8)
+					} else {
+						out.Children = []int64{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int64
+					v4 = int64(in.Int64())
+					out.Children = append(out.Children, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations4(out *jwriter.Writer, in CachedLocation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ExpiresAt))
+	}
+	{
+		const prefix string = ",\"location\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData3(out, in.Location)
+	}
+	if in.ETag != "" {
+		const prefix string = ",\"etag\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ETag))
+	}
+	{
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Version))
+	}
+	if len(in.Children) != 0 {
+		const prefix string = ",\"children\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Children {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CachedLocation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CachedLocation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CachedLocation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CachedLocation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations4(l, v)
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in *jlexer.Lexer, out *staticData.Location) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "region":
+			if in.IsNull() {
+				in.Skip()
+				out.Region = nil
+			} else {
+				if out.Region == nil {
+					out.Region = new(staticData.Region)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in, &*out.Region)
+			}
+		case "constellation":
+			if in.IsNull() {
+				in.Skip()
+				out.Constellation = nil
+			} else {
+				if out.Constellation == nil {
+					out.Constellation = new(staticData.Constellation)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in, &*out.Constellation)
+			}
+		case "solar_system":
+			if in.IsNull() {
+				in.Skip()
+				out.SolarSystem = nil
+			} else {
+				if out.SolarSystem == nil {
+					out.SolarSystem = new(staticData.SolarSystem)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData6(in, &*out.SolarSystem)
+			}
+		case "station":
+			if in.IsNull() {
+				in.Skip()
+				out.Station = nil
+			} else {
+				if out.Station == nil {
+					out.Station = new(staticData.Station)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData7(in, &*out.Station)
+			}
+		case "celestial":
+			if in.IsNull() {
+				in.Skip()
+				out.Celestial = nil
+			} else {
+				if out.Celestial == nil {
+					out.Celestial = new(staticData.Celestial)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData8(in, &*out.Celestial)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData3(out *jwriter.Writer, in staticData.Location) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Region != nil {
+		const prefix string = ",\"region\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData4(out, *in.Region)
+	}
+	if in.Constellation != nil {
+		const prefix string = ",\"constellation\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData5(out, *in.Constellation)
+	}
+	if in.SolarSystem != nil {
+		const prefix string = ",\"solar_system\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData6(out, *in.SolarSystem)
+	}
+	if in.Station != nil {
+		const prefix string = ",\"station\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData7(out, *in.Station)
+	}
+	if in.Celestial != nil {
+		const prefix string = ",\"celestial\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData8(out, *in.Celestial)
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData8(in *jlexer.Lexer, out *staticData.Celestial) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "kind":
+			out.Kind = staticData.Celestial_Kind(in.Int32())
+		case "type_id":
+			out.TypeId = int64(in.Int64())
+		case "coordinates":
+			if in.IsNull() {
+				in.Skip()
+				out.Coordinates = nil
+			} else {
+				if out.Coordinates == nil {
+					out.Coordinates = new(staticData.Coordinates)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in, &*out.Coordinates)
+			}
+		case "destination_system_id":
+			out.DestinationSystemId = int64(in.Int64())
+		case "destination_stargate_id":
+			out.DestinationStargateId = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData8(out *jwriter.Writer, in staticData.Celestial) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Id))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Kind != 0 {
+		const prefix string = ",\"kind\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Kind))
+	}
+	if in.TypeId != 0 {
+		const prefix string = ",\"type_id\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData2(out, *in.Region)
+		out.Int64(int64(in.TypeId))
 	}
-	if in.Constellation != nil {
-		const prefix string = ",\"constellation\":"
+	if in.Coordinates != nil {
+		const prefix string = ",\"coordinates\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData3(out, *in.Constellation)
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out, *in.Coordinates)
 	}
-	if in.SolarSystem != nil {
-		const prefix string = ",\"solar_system\":"
+	if in.DestinationSystemId != 0 {
+		const prefix string = ",\"destination_system_id\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData4(out, *in.SolarSystem)
+		out.Int64(int64(in.DestinationSystemId))
 	}
-	if in.Station != nil {
-		const prefix string = ",\"station\":"
+	if in.DestinationStargateId != 0 {
+		const prefix string = ",\"destination_stargate_id\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData5(out, *in.Station)
+		out.Int64(int64(in.DestinationStargateId))
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in *jlexer.Lexer, out *staticData.Station) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData7(in *jlexer.Lexer, out *staticData.Station) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -554,6 +1431,55 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in *jlexer.
 				}
 				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in, &*out.Coordinates)
 			}
+		case "owner_id":
+			out.OwnerId = int64(in.Int64())
+		case "owner_name":
+			out.OwnerName = string(in.String())
+		case "race_id":
+			out.RaceId = int64(in.Int64())
+		case "services":
+			if in.IsNull() {
+				in.Skip()
+				out.Services = nil
+			} else {
+				in.Delim('[')
+				if out.Services == nil {
+					if !in.IsDelim(']') {
+						out.Services = make([]string, 0, 4)
+					} else {
+						out.Services = []string{}
+					}
+				} else {
+					out.Services = (out.Services)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Services = append(out.Services, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reprocessing_efficiency":
+			out.ReprocessingEfficiency = float64(in.Float64())
+		case "reprocessing_stations_take":
+			out.ReprocessingStationsTake = float64(in.Float64())
+		case "max_dockable_ship_volume":
+			out.MaxDockableShipVolume = float64(in.Float64())
+		case "office_rental_cost":
+			out.OfficeRentalCost = float64(in.Float64())
+		case "status":
+			out.Status = staticData.Station_Status(in.Int32())
+		case "removed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RemovedAt = nil
+			} else {
+				if out.RemovedAt == nil {
+					out.RemovedAt = new(timestamp.Timestamp)
+				}
+				easyjson6601e8cdDecodeGithubComGolangProtobufPtypesTimestamp(in, &*out.RemovedAt)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -564,7 +1490,7 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData5(out *jwriter.Writer, in staticData.Station) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData7(out *jwriter.Writer, in staticData.Station) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -648,68 +1574,118 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData5(out *jwrite
 		}
 		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out, *in.Coordinates)
 	}
-	out.RawByte('}')
-}
-func easyjson6601e8cdDecodeGithubComGolangProtobufPtypesTimestamp(in *jlexer.Lexer, out *timestamp.Timestamp) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
+	if in.OwnerId != 0 {
+		const prefix string = ",\"owner_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.Skip()
-		return
+		out.Int64(int64(in.OwnerId))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+	if in.OwnerName != "" {
+		const prefix string = ",\"owner_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		switch key {
-		case "seconds":
-			out.Seconds = int64(in.Int64())
-		case "nanos":
-			out.Nanos = int32(in.Int32())
-		default:
-			in.SkipRecursive()
+		out.String(string(in.OwnerName))
+	}
+	if in.RaceId != 0 {
+		const prefix string = ",\"race_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.WantComma()
+		out.Int64(int64(in.RaceId))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	if len(in.Services) != 0 {
+		const prefix string = ",\"services\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Services {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
 	}
-}
-func easyjson6601e8cdEncodeGithubComGolangProtobufPtypesTimestamp(out *jwriter.Writer, in timestamp.Timestamp) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.Seconds != 0 {
-		const prefix string = ",\"seconds\":"
+	if in.ReprocessingEfficiency != 0 {
+		const prefix string = ",\"reprocessing_efficiency\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int64(int64(in.Seconds))
+		out.Float64(float64(in.ReprocessingEfficiency))
 	}
-	if in.Nanos != 0 {
-		const prefix string = ",\"nanos\":"
+	if in.ReprocessingStationsTake != 0 {
+		const prefix string = ",\"reprocessing_stations_take\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int32(int32(in.Nanos))
+		out.Float64(float64(in.ReprocessingStationsTake))
+	}
+	if in.MaxDockableShipVolume != 0 {
+		const prefix string = ",\"max_dockable_ship_volume\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.MaxDockableShipVolume))
+	}
+	if in.OfficeRentalCost != 0 {
+		const prefix string = ",\"office_rental_cost\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.OfficeRentalCost))
+	}
+	if in.Status != 0 {
+		const prefix string = ",\"status\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Status))
+	}
+	if in.RemovedAt != nil {
+		const prefix string = ",\"removed_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComGolangProtobufPtypesTimestamp(out, *in.RemovedAt)
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in *jlexer.Lexer, out *staticData.SolarSystem) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData6(in *jlexer.Lexer, out *staticData.SolarSystem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -734,6 +1710,72 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in *jlexer.
 			out.SecurityStatus = float64(in.Float64())
 		case "name":
 			out.Name = string(in.String())
+		case "coordinates":
+			if in.IsNull() {
+				in.Skip()
+				out.Coordinates = nil
+			} else {
+				if out.Coordinates == nil {
+					out.Coordinates = new(staticData.Coordinates)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in, &*out.Coordinates)
+			}
+		case "star_id":
+			out.StarId = int64(in.Int64())
+		case "security_class":
+			out.SecurityClass = string(in.String())
+		case "planet_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.PlanetIds = nil
+			} else {
+				in.Delim('[')
+				if out.PlanetIds == nil {
+					if !in.IsDelim(']') {
+						out.PlanetIds = make([]int64, 0, 8)
+					} else {
+						out.PlanetIds = []int64{}
+					}
+				} else {
+					out.PlanetIds = (out.PlanetIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 int64
+					v10 = int64(in.Int64())
+					out.PlanetIds = append(out.PlanetIds, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "wormhole_class":
+			out.WormholeClass = staticData.SolarSystem_WormholeClass(in.Int32())
+		case "wormhole_effect":
+			out.WormholeEffect = staticData.SolarSystem_WormholeEffect(in.Int32())
+		case "faction_id":
+			out.FactionId = int64(in.Int64())
+		case "stargate_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.StargateIds = nil
+			} else {
+				in.Delim('[')
+				if out.StargateIds == nil {
+					if !in.IsDelim(']') {
+						out.StargateIds = make([]int64, 0, 8)
+					} else {
+						out.StargateIds = []int64{}
+					}
+				} else {
+					out.StargateIds = (out.StargateIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 int64
+					v15 = int64(in.Int64())
+					out.StargateIds = append(out.StargateIds, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -744,7 +1786,7 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData4(out *jwriter.Writer, in staticData.SolarSystem) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData6(out *jwriter.Writer, in staticData.SolarSystem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -778,9 +1820,107 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData4(out *jwrite
 		}
 		out.String(string(in.Name))
 	}
+	if in.Coordinates != nil {
+		const prefix string = ",\"coordinates\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out, *in.Coordinates)
+	}
+	if in.StarId != 0 {
+		const prefix string = ",\"star_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.StarId))
+	}
+	if in.SecurityClass != "" {
+		const prefix string = ",\"security_class\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SecurityClass))
+	}
+	if len(in.PlanetIds) != 0 {
+		const prefix string = ",\"planet_ids\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.PlanetIds {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.WormholeClass != 0 {
+		const prefix string = ",\"wormhole_class\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.WormholeClass))
+	}
+	if in.WormholeEffect != 0 {
+		const prefix string = ",\"wormhole_effect\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.WormholeEffect))
+	}
+	if in.FactionId != 0 {
+		const prefix string = ",\"faction_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.FactionId))
+	}
+	if len(in.StargateIds) != 0 {
+		const prefix string = ",\"stargate_ids\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v16, v17 := range in.StargateIds {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v17))
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in *jlexer.Lexer, out *staticData.Constellation) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData5(in *jlexer.Lexer, out *staticData.Constellation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -803,6 +1943,18 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in *jlexer.
 			out.Id = int64(in.Int64())
 		case "name":
 			out.Name = string(in.String())
+		case "coordinates":
+			if in.IsNull() {
+				in.Skip()
+				out.Coordinates = nil
+			} else {
+				if out.Coordinates == nil {
+					out.Coordinates = new(staticData.Coordinates)
+				}
+				easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData(in, &*out.Coordinates)
+			}
+		case "faction_id":
+			out.FactionId = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -813,7 +1965,7 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData3(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData3(out *jwriter.Writer, in staticData.Constellation) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData5(out *jwriter.Writer, in staticData.Constellation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -837,9 +1989,29 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData3(out *jwrite
 		}
 		out.String(string(in.Name))
 	}
+	if in.Coordinates != nil {
+		const prefix string = ",\"coordinates\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData(out, *in.Coordinates)
+	}
+	if in.FactionId != 0 {
+		const prefix string = ",\"faction_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.FactionId))
+	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in *jlexer.Lexer, out *staticData.Region) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData4(in *jlexer.Lexer, out *staticData.Region) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -862,6 +2034,10 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in *jlexer.
 			out.Id = int64(in.Int64())
 		case "name":
 			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "faction_id":
+			out.FactionId = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -872,7 +2048,7 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibStaticData2(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData2(out *jwriter.Writer, in staticData.Region) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData4(out *jwriter.Writer, in staticData.Region) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -896,9 +2072,29 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibStaticData2(out *jwrite
 		}
 		out.String(string(in.Name))
 	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.FactionId != 0 {
+		const prefix string = ",\"faction_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.FactionId))
+	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(in *jlexer.Lexer, out *AllStructures) {
+func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations5(in *jlexer.Lexer, out *AllStructures) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -912,9 +2108,9 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(in *jlexer.L
 		for !in.IsDelim('}') {
 			key := string(in.String())
 			in.WantColon()
-			var v1 Structure
-			(v1).UnmarshalEasyJSON(in)
-			(*out)[key] = v1
+			var v13 Structure
+			(v13).UnmarshalEasyJSON(in)
+			(*out)[key] = v13
 			in.WantComma()
 		}
 		in.Delim('}')
@@ -923,21 +2119,21 @@ func easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(out *jwriter.Writer, in AllStructures) {
+func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations5(out *jwriter.Writer, in AllStructures) {
 	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
 		out.RawString(`null`)
 	} else {
 		out.RawByte('{')
-		v2First := true
-		for v2Name, v2Value := range in {
-			if v2First {
-				v2First = false
+		v14First := true
+		for v14Name, v14Value := range in {
+			if v14First {
+				v14First = false
 			} else {
 				out.RawByte(',')
 			}
-			out.String(string(v2Name))
+			out.String(string(v14Name))
 			out.RawByte(':')
-			(v2Value).MarshalEasyJSON(out)
+			(v14Value).MarshalEasyJSON(out)
 		}
 		out.RawByte('}')
 	}
@@ -946,23 +2142,23 @@ func easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AllStructures) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(&w, v)
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AllStructures) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations2(w, v)
+	easyjson6601e8cdEncodeGithubComEVEToolsStaticDataLibLocations5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllStructures) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(&r, v)
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AllStructures) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations2(l, v)
+	easyjson6601e8cdDecodeGithubComEVEToolsStaticDataLibLocations5(l, v)
 }
@@ -0,0 +1,62 @@
+package locations
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+)
+
+// IDs ESI does not know are not requested again until this expires
+const unknownLocationTTL = 24 * time.Hour
+
+// Maps IDs ESI reported as not found onto the entry's expiry, so they don't cost error budget on every request
+const unknownLocationsBucket = "unknownLocations"
+
+// Error returned for IDs ESI recently reported as not found
+func unknownLocationError(id int64) error {
+	msg := fmt.Sprintf("Location %d is unknown to ESI", id)
+	return &LocationError{ID: id, Reason: pb.LocationFailure_UNKNOWN, Err: errors.New(msg)}
+}
+
+// Check whether ESI recently reported an ID as not found
+func isUnknownLocation(id int64) (bool, error) {
+	var unknown bool
+
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(unknownLocationsBucket))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		value := bucket.Get([]byte(strconv.FormatInt(id, 10)))
+		if value == nil {
+			return nil
+		}
+
+		expiresAt, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		unknown = expiresAt > time.Now().Unix()
+		return nil
+	})
+
+	return unknown, err
+}
+
+// Remember that ESI does not know an ID until expiresAt
+func rememberUnknownLocation(id int64, expiresAt time.Time) error {
+	return db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(unknownLocationsBucket))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		return bucket.Put([]byte(strconv.FormatInt(id, 10)), []byte(strconv.FormatInt(expiresAt.Unix(), 10)))
+	})
+}
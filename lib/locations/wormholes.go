@@ -0,0 +1,188 @@
+package locations
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Wormhole classes of J-space regions, bundled as ESI does not expose them
+var wormholeRegionClasses = []struct {
+	firstRegionID int64
+	lastRegionID  int64
+	class         pb.SolarSystem_WormholeClass
+}{
+	{11000001, 11000003, pb.SolarSystem_C1},
+	{11000004, 11000008, pb.SolarSystem_C2},
+	{11000009, 11000015, pb.SolarSystem_C3},
+	{11000016, 11000023, pb.SolarSystem_C4},
+	{11000024, 11000029, pb.SolarSystem_C5},
+	{11000030, 11000030, pb.SolarSystem_C6},
+	{11000031, 11000031, pb.SolarSystem_THERA},
+	{11000032, 11000032, pb.SolarSystem_SHATTERED},
+	{11000033, 11000033, pb.SolarSystem_DRIFTER},
+}
+
+// Type IDs of the secondary suns causing wormhole effects
+var wormholeEffectTypes = map[int64]pb.SolarSystem_WormholeEffect{
+	30574: pb.SolarSystem_MAGNETAR,
+	30575: pb.SolarSystem_BLACK_HOLE,
+	30576: pb.SolarSystem_RED_GIANT,
+	30577: pb.SolarSystem_PULSAR,
+	30669: pb.SolarSystem_WOLF_RAYET,
+	30670: pb.SolarSystem_CATACLYSMIC_VARIABLE,
+}
+
+// Wormhole classes and effects imported from the SDE, indexed by region, constellation or solar system ID. These take
+// precedence over the bundled table. Only written on initialization.
+var sdeWormholeClasses = make(map[int64]pb.SolarSystem_WormholeClass)
+var sdeWormholeEffects = make(map[int64]pb.SolarSystem_WormholeEffect)
+
+// Set once all wormhole effects were imported from the SDE, systems missing in it have no effect
+var sdeWormholeEffectsImported bool
+
+// Get the wormhole class of a solar system, the most specific entry of the SDE wins
+func wormholeClassOf(systemID int64, constellationID int64, regionID int64) pb.SolarSystem_WormholeClass {
+	for _, id := range []int64{systemID, constellationID, regionID} {
+		if class, ok := sdeWormholeClasses[id]; ok {
+			return class
+		}
+	}
+
+	for _, entry := range wormholeRegionClasses {
+		if regionID >= entry.firstRegionID && regionID <= entry.lastRegionID {
+			return entry.class
+		}
+	}
+
+	return pb.SolarSystem_NOT_WORMHOLE
+}
+
+// Get the wormhole effect of a solar system. Effects of wormhole systems are unknown unless the SDE was imported.
+func wormholeEffectOf(systemID int64, class pb.SolarSystem_WormholeClass) pb.SolarSystem_WormholeEffect {
+	if sdeWormholeEffectsImported {
+		if effect, ok := sdeWormholeEffects[systemID]; ok {
+			return effect
+		}
+
+		return pb.SolarSystem_NO_EFFECT
+	}
+
+	if class == pb.SolarSystem_NOT_WORMHOLE {
+		return pb.SolarSystem_NO_EFFECT
+	}
+
+	return pb.SolarSystem_UNKNOWN_EFFECT
+}
+
+// Convert the SDE's wormholeClassID, known space classes are not wormholes
+func wormholeClassFromSDE(classID int64) (pb.SolarSystem_WormholeClass, bool) {
+	switch {
+	case classID >= 1 && classID <= 6:
+		return pb.SolarSystem_WormholeClass(classID), true
+	case classID >= 7 && classID <= 9:
+		return pb.SolarSystem_NOT_WORMHOLE, true
+	case classID == 12:
+		return pb.SolarSystem_THERA, true
+	case classID == 13:
+		return pb.SolarSystem_SHATTERED, true
+	case classID >= 14 && classID <= 18:
+		return pb.SolarSystem_DRIFTER, true
+	default:
+		return pb.SolarSystem_NOT_WORMHOLE, false
+	}
+}
+
+// Import wormhole classes and effects from an SDE CSV export (mapLocationWormholeClasses.csv and mapDenormalize.csv)
+func importWormholeSDE(path string) error {
+	err := readCSV(filepath.Join(path, "mapLocationWormholeClasses.csv"), func(row map[string]string) error {
+		locationID, err := strconv.ParseInt(row["locationID"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		classID, err := strconv.ParseInt(row["wormholeClassID"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if class, ok := wormholeClassFromSDE(classID); ok {
+			sdeWormholeClasses[locationID] = class
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = readCSV(filepath.Join(path, "mapDenormalize.csv"), func(row map[string]string) error {
+		typeID, err := strconv.ParseInt(row["typeID"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		effect, ok := wormholeEffectTypes[typeID]
+		if !ok {
+			return nil
+		}
+
+		systemID, err := strconv.ParseInt(row["solarSystemID"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		sdeWormholeEffects[systemID] = effect
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sdeWormholeEffectsImported = true
+
+	logrus.Infof("Imported %d wormhole classes and %d wormhole effects from SDE.", len(sdeWormholeClasses), len(sdeWormholeEffects))
+	return nil
+}
+
+// Read a CSV file with header, rows are passed to handle as maps indexed by column name
+func readCSV(path string, handle func(map[string]string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return errors.Wrapf(err, "could not read header of %s", path)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not read %s", path)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[column] = record[i]
+			}
+		}
+
+		err = handle(row)
+		if err != nil {
+			return errors.Wrapf(err, "invalid row in %s", path)
+		}
+	}
+}
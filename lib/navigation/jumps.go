@@ -0,0 +1,110 @@
+package navigation
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Longest range of any jump drive in light years (jump freighters with Jump Drive Calibration V, Black Ops only reach
+// 8), used for bounding queries
+const maxJumpRange = 10
+
+// Pochven's region, its systems have a security status of -1.0 but jump drives can't be used there
+const pochvenRegionID = 10000070
+
+// Zarzakh is reachable via stargates only
+const zarzakhSystemID = 30100000
+
+// GetDistance returns the direct distance between two solar systems in light years
+func GetDistance(context context.Context, request *pb.GetDistanceRequest) (*pb.GetDistanceResponse, error) {
+	graphMutex.RLock()
+	defer graphMutex.RUnlock()
+
+	if len(graph) == 0 {
+		return nil, status.Error(codes.Unavailable, "Stargate graph not loaded yet")
+	}
+
+	origin, ok := graph[request.GetOriginId()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown origin")
+	}
+
+	destination, ok := graph[request.GetDestinationId()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown destination")
+	}
+
+	return &pb.GetDistanceResponse{
+		LightYears: distance(origin, destination),
+	}, nil
+}
+
+// GetSystemsInJumpRange returns all systems a jump drive with the given range can jump to, nearest first
+func GetSystemsInJumpRange(context context.Context, request *pb.GetSystemsInJumpRangeRequest) (*pb.GetSystemsInJumpRangeResponse, error) {
+	if request.GetRange() <= 0 || request.GetRange() > maxJumpRange {
+		return nil, status.Error(codes.InvalidArgument, "Range must be greater than 0 and at most 10 light years")
+	}
+
+	graphMutex.RLock()
+	defer graphMutex.RUnlock()
+
+	if len(graph) == 0 {
+		return nil, status.Error(codes.Unavailable, "Stargate graph not loaded yet")
+	}
+
+	origin, ok := graph[request.GetOriginId()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown origin")
+	}
+
+	if isWormhole(origin) {
+		return nil, status.Error(codes.FailedPrecondition, "Jump drives can not be used in wormhole space")
+	}
+
+	if isHighsec(origin) {
+		return nil, status.Error(codes.FailedPrecondition, "Jump drives can not be used in highsec")
+	}
+
+	if isJumpRestricted(origin) {
+		return nil, status.Error(codes.FailedPrecondition, "Jump drives can not be used in this system")
+	}
+
+	var systems []*pb.SystemDistance
+	for _, system := range systemIndex.inRange(origin, request.GetRange()) {
+		if system == origin || !isJumpDestination(system) {
+			continue
+		}
+
+		systems = append(systems, &pb.SystemDistance{
+			SystemId:   system.ID,
+			LightYears: distance(origin, system),
+		})
+	}
+
+	sort.Slice(systems, func(i, j int) bool {
+		return systems[i].LightYears < systems[j].LightYears
+	})
+
+	return &pb.GetSystemsInJumpRangeResponse{
+		Systems: systems,
+	}, nil
+}
+
+// Check if capital ships can jump into a system: not into highsec, wormhole space, Pochven or Zarzakh
+func isJumpDestination(system *SystemNode) bool {
+	return !isHighsec(system) && !isWormhole(system) && !isJumpRestricted(system)
+}
+
+// Check if a system is in k-space, but jump drives can't be used in it (Pochven and Zarzakh)
+func isJumpRestricted(system *SystemNode) bool {
+	return system.RegionID == pochvenRegionID || system.ID == zarzakhSystemID
+}
+
+// Check if a system is in wormhole space (or any other space not part of k-space)
+func isWormhole(system *SystemNode) bool {
+	return system.ID < 30000000 || system.ID >= 31000000
+}
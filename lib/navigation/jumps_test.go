@@ -0,0 +1,68 @@
+package navigation
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Systems placed along the x axis, one light year apart
+var jumpTestSystems = []SystemNode{
+	{ID: 30002187, SecurityStatus: 0.3, RegionID: 10000042, X: 0},
+	{ID: 30001198, SecurityStatus: -0.4, RegionID: 10000014, X: 1 * metersPerLightYear},
+	{ID: 30000142, SecurityStatus: 0.9, RegionID: 10000002, X: 2 * metersPerLightYear},
+	{ID: 30000157, SecurityStatus: -1.0, RegionID: pochvenRegionID, X: 3 * metersPerLightYear},
+	{ID: zarzakhSystemID, SecurityStatus: -1.0, RegionID: 10001000, X: 4 * metersPerLightYear},
+	{ID: 31000005, SecurityStatus: -1.0, RegionID: 11000031, X: 5 * metersPerLightYear},
+}
+
+func TestGetSystemsInJumpRange(t *testing.T) {
+	setGraph(append([]SystemNode(nil), jumpTestSystems...))
+
+	tests := []struct {
+		name         string
+		originID     int64
+		destinations []int64
+		code         codes.Code
+	}{
+		{"lowsec origin only reaches nullsec", 30002187, []int64{30001198}, codes.OK},
+		{"nullsec origin reaches lowsec", 30001198, []int64{30002187}, codes.OK},
+		{"highsec origin", 30000142, nil, codes.FailedPrecondition},
+		{"Pochven origin", 30000157, nil, codes.FailedPrecondition},
+		{"Zarzakh origin", zarzakhSystemID, nil, codes.FailedPrecondition},
+		{"wormhole origin", 31000005, nil, codes.FailedPrecondition},
+		{"unknown origin", 30000001, nil, codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := GetSystemsInJumpRange(context.Background(), &pb.GetSystemsInJumpRangeRequest{
+				OriginId: test.originID,
+				Range:    maxJumpRange,
+			})
+			if status.Code(err) != test.code {
+				t.Fatalf("expected code %s, got %v", test.code, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var destinations []int64
+			for _, system := range response.Systems {
+				destinations = append(destinations, system.SystemId)
+			}
+
+			if len(destinations) != len(test.destinations) {
+				t.Fatalf("expected destinations %v, got %v", test.destinations, destinations)
+			}
+			for i := range destinations {
+				if destinations[i] != test.destinations[i] {
+					t.Fatalf("expected destinations %v, got %v", test.destinations, destinations)
+				}
+			}
+		})
+	}
+}
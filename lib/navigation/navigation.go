@@ -0,0 +1,226 @@
+package navigation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRoute returns the route between two solar systems, computed from the cached stargate graph
+func GetRoute(context context.Context, request *pb.GetRouteRequest) (*pb.GetRouteResponse, error) {
+	graphMutex.RLock()
+	defer graphMutex.RUnlock()
+
+	if len(graph) == 0 {
+		return nil, status.Error(codes.Unavailable, "Stargate graph not loaded yet")
+	}
+
+	if _, ok := graph[request.GetOriginId()]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown origin")
+	}
+
+	if _, ok := graph[request.GetDestinationId()]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown destination")
+	}
+
+	route := findRoute(request.GetOriginId(), request.GetDestinationId(), request.GetPreference(), request.GetAvoidIds())
+	if route == nil {
+		return nil, status.Error(codes.NotFound, "No route found")
+	}
+
+	return &pb.GetRouteResponse{
+		SystemIds: route,
+		Jumps:     int32(len(route) - 1),
+	}, nil
+}
+
+// SystemLoader loads all solar systems and their stargate connections, also returns the IDs of systems which could not
+// be loaded.
+type SystemLoader func(ctx context.Context) ([]SystemNode, []int64, error)
+
+var db *bolt.DB
+var loadSystems SystemLoader
+
+// Stargate graph indexed by solar system ID and spatial index of its systems
+var graph map[int64]*SystemNode
+var systemIndex *spatialIndex
+var graphMutex sync.RWMutex
+
+// Version of the cached graph's schema, bump when adding fields to SystemNode
+const graphVersion = 2
+
+// Initialize initializes infrastructure for navigation, the graph is built from the systems returned by loader
+func Initialize(database *bolt.DB, loader SystemLoader) {
+	db = database
+	loadSystems = loader
+
+	// Initialize buckets
+	err := db.Update(func(tx *bolt.Tx) error {
+		tx.CreateBucketIfNotExists([]byte("stargateGraph"))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Load
+	go scheduleGraphUpdate()
+}
+
+// Keep ticking in own goroutine and spawn worker tasks.
+func scheduleGraphUpdate() {
+	// Load persisted graph on start, only rebuild it if it is missing or expired...
+	expiresAt, err := loadGraph()
+	if err != nil {
+		logrus.WithError(err).Warn("could not load stargate graph")
+	}
+
+	if expiresAt < time.Now().Unix() {
+		go updateGraph()
+	}
+
+	// ...then update every 24 hours
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		go updateGraph()
+	}
+}
+
+// Load graph from BoltDB, returns the graph's expiry
+func loadGraph() (int64, error) {
+	var graphBlob []byte
+	db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte("stargateGraph"))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		graphBlob = bucket.Get([]byte("graph"))
+		return nil
+	})
+
+	if graphBlob == nil {
+		return 0, nil
+	}
+
+	var cachedGraph CachedGraph
+	err := cachedGraph.UnmarshalJSON(graphBlob)
+	if err != nil {
+		return 0, err
+	}
+
+	// Outdated graphs lack fields, rebuild them
+	if cachedGraph.Version < graphVersion {
+		return 0, nil
+	}
+
+	setGraph(cachedGraph.Systems)
+
+	return cachedGraph.ExpiresAt, nil
+}
+
+// Replace the in-memory graph
+func setGraph(systems []SystemNode) {
+	newGraph := make(map[int64]*SystemNode, len(systems))
+	for i := range systems {
+		newGraph[systems[i].ID] = &systems[i]
+	}
+
+	newIndex := newSpatialIndex(spatialIndexCellSize)
+	for _, system := range newGraph {
+		newIndex.insert(system)
+	}
+
+	graphMutex.Lock()
+	graph = newGraph
+	systemIndex = newIndex
+	graphMutex.Unlock()
+}
+
+func updateGraph() {
+	logrus.Info("Updating stargate graph...")
+
+	systems, failed, err := loadSystems(context.Background())
+	if err != nil {
+		logrus.WithError(err).Warn("could not update stargate graph")
+		return
+	}
+
+	if len(failed) > 0 {
+		var kept int
+		systems, kept = keepPreviousSystems(systems, failed)
+		logrus.Warnf("Could not load %d systems, kept %d of them from the previous stargate graph", len(failed), kept)
+	}
+
+	cachedGraph := CachedGraph{
+		Systems:   systems,
+		ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
+		Version:   graphVersion,
+	}
+
+	blob, err := cachedGraph.MarshalJSON()
+	if err != nil {
+		logrus.WithError(err).Warn("could not marshal stargate graph")
+		return
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte("stargateGraph"))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		return bucket.Put([]byte("graph"), blob)
+	})
+	if err != nil {
+		logrus.WithError(err).Warn("could not store stargate graph")
+		return
+	}
+
+	setGraph(systems)
+
+	logrus.Infof("Done updating stargate graph, got %d systems!", len(systems))
+}
+
+// Add the previous graph's nodes of systems which could not be loaded, so a single failure does not remove a system
+// from routes. Returns the systems and the number of nodes kept.
+func keepPreviousSystems(systems []SystemNode, failed []int64) ([]SystemNode, int) {
+	graphMutex.RLock()
+	defer graphMutex.RUnlock()
+
+	var kept int
+	for _, id := range failed {
+		if system, ok := graph[id]; ok {
+			systems = append(systems, *system)
+			kept++
+		}
+	}
+
+	return systems, kept
+}
+
+// SystemsWithinJumps returns the IDs of all systems reachable from a system via stargates within the given number of
+// jumps, mapped to their jump count
+func SystemsWithinJumps(originID int64, maxJumps int) (map[int64]int, error) {
+	graphMutex.RLock()
+	defer graphMutex.RUnlock()
+
+	if len(graph) == 0 {
+		return nil, errors.New("stargate graph not loaded yet")
+	}
+
+	if _, ok := graph[originID]; !ok {
+		return nil, errors.Errorf("unknown system %d", originID)
+	}
+
+	return systemsWithinJumps(originID, maxJumps), nil
+}
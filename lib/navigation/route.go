@@ -0,0 +1,157 @@
+package navigation
+
+import (
+	"container/heap"
+	"math"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+)
+
+// Cost of a jump into a system which does not match the route's preference, high enough to take any detour first
+const avoidedJumpCost = 50000
+
+// Find the route between two systems using Dijkstra's algorithm, returns nil if there is none. Avoided systems are
+// only used as origin or destination. The graph's read lock must be held.
+func findRoute(originID int64, destinationID int64, preference pb.GetRouteRequest_Preference, avoidIDs []int64) []int64 {
+	avoid := make(map[int64]struct{}, len(avoidIDs))
+	for _, id := range avoidIDs {
+		avoid[id] = struct{}{}
+	}
+
+	costs := map[int64]int{originID: 0}
+	previous := make(map[int64]int64)
+	visited := make(map[int64]struct{})
+
+	queue := &routeQueue{{systemID: originID, cost: 0}}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(routeStep)
+		if _, ok := visited[current.systemID]; ok {
+			continue
+		}
+		visited[current.systemID] = struct{}{}
+
+		if current.systemID == destinationID {
+			break
+		}
+
+		for _, neighbourID := range graph[current.systemID].Neighbours {
+			neighbour, ok := graph[neighbourID]
+			if !ok {
+				continue
+			}
+
+			if _, ok := avoid[neighbourID]; ok && neighbourID != destinationID {
+				continue
+			}
+
+			cost := current.cost + jumpCost(neighbour, preference)
+			if knownCost, ok := costs[neighbourID]; ok && knownCost <= cost {
+				continue
+			}
+
+			costs[neighbourID] = cost
+			previous[neighbourID] = current.systemID
+			heap.Push(queue, routeStep{systemID: neighbourID, cost: cost})
+		}
+	}
+
+	if _, ok := visited[destinationID]; !ok {
+		return nil
+	}
+
+	// Walk back from destination
+	route := []int64{destinationID}
+	for id := destinationID; id != originID; {
+		id = previous[id]
+		route = append(route, id)
+	}
+
+	for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+		route[i], route[j] = route[j], route[i]
+	}
+
+	return route
+}
+
+// Get the cost of jumping into a system
+func jumpCost(system *SystemNode, preference pb.GetRouteRequest_Preference) int {
+	switch preference {
+	case pb.GetRouteRequest_SAFER:
+		if !isHighsec(system) {
+			return avoidedJumpCost
+		}
+	case pb.GetRouteRequest_LESS_SECURE:
+		if isHighsec(system) {
+			return avoidedJumpCost
+		}
+	}
+
+	return 1
+}
+
+// Check if a system is highsec, the security status is rounded like in game
+func isHighsec(system *SystemNode) bool {
+	return math.Floor(system.SecurityStatus*10+0.5)/10 >= 0.5
+}
+
+// A system reached while searching for a route
+type routeStep struct {
+	systemID int64
+	cost     int
+}
+
+// routeQueue is a priority queue of route steps, cheapest first. It implements heap.Interface.
+type routeQueue []routeStep
+
+func (queue routeQueue) Len() int {
+	return len(queue)
+}
+
+func (queue routeQueue) Less(i, j int) bool {
+	return queue[i].cost < queue[j].cost
+}
+
+func (queue routeQueue) Swap(i, j int) {
+	queue[i], queue[j] = queue[j], queue[i]
+}
+
+func (queue *routeQueue) Push(step interface{}) {
+	*queue = append(*queue, step.(routeStep))
+}
+
+func (queue *routeQueue) Pop() interface{} {
+	old := *queue
+	step := old[len(old)-1]
+	*queue = old[:len(old)-1]
+	return step
+}
+
+// Get all systems reachable within the given number of jumps and their jump count using breadth-first search. The
+// graph's read lock must be held.
+func systemsWithinJumps(originID int64, maxJumps int) map[int64]int {
+	jumps := map[int64]int{originID: 0}
+	frontier := []int64{originID}
+
+	for jump := 1; jump <= maxJumps && len(frontier) > 0; jump++ {
+		var next []int64
+		for _, systemID := range frontier {
+			system, ok := graph[systemID]
+			if !ok {
+				continue
+			}
+
+			for _, neighbourID := range system.Neighbours {
+				if _, ok := jumps[neighbourID]; ok {
+					continue
+				}
+
+				jumps[neighbourID] = jump
+				next = append(next, neighbourID)
+			}
+		}
+
+		frontier = next
+	}
+
+	return jumps
+}
@@ -0,0 +1,74 @@
+package navigation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/EVE-Tools/static-data/lib/staticData"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Hand-built graph with three routes from origin 1 to destination 4: 1, 7, 4 is highsec only with 2 jumps, 1, 5, 6, 4
+// leads through lowsec with 3 jumps and 1, 2, 3, 8, 4 is highsec only with 4 jumps. System 9 is not connected at all.
+var routeTestSystems = []SystemNode{
+	{ID: 1, SecurityStatus: 0.9, Neighbours: []int64{7, 5, 2}},
+	{ID: 2, SecurityStatus: 0.8, Neighbours: []int64{1, 3}},
+	{ID: 3, SecurityStatus: 0.7, Neighbours: []int64{2, 8}},
+	{ID: 4, SecurityStatus: 0.9, Neighbours: []int64{7, 6, 8}},
+	{ID: 5, SecurityStatus: 0.3, Neighbours: []int64{1, 6}},
+	{ID: 6, SecurityStatus: 0.1, Neighbours: []int64{5, 4}},
+	{ID: 7, SecurityStatus: 0.5, Neighbours: []int64{1, 4}},
+	{ID: 8, SecurityStatus: 0.6, Neighbours: []int64{3, 4}},
+	{ID: 9, SecurityStatus: 0.9},
+}
+
+func TestGetRoute(t *testing.T) {
+	setGraph(append([]SystemNode(nil), routeTestSystems...))
+
+	tests := []struct {
+		name          string
+		destinationID int64
+		preference    pb.GetRouteRequest_Preference
+		avoidIDs      []int64
+		route         []int64
+		code          codes.Code
+	}{
+		{"shortest", 4, pb.GetRouteRequest_SHORTEST, nil, []int64{1, 7, 4}, codes.OK},
+		{"safer", 4, pb.GetRouteRequest_SAFER, nil, []int64{1, 7, 4}, codes.OK},
+		{"less secure prefers lowsec detour", 4, pb.GetRouteRequest_LESS_SECURE, nil, []int64{1, 5, 6, 4}, codes.OK},
+		{"shortest avoiding system", 4, pb.GetRouteRequest_SHORTEST, []int64{7}, []int64{1, 5, 6, 4}, codes.OK},
+		{"safer avoiding system takes longer highsec route", 4, pb.GetRouteRequest_SAFER, []int64{7}, []int64{1, 2, 3, 8, 4}, codes.OK},
+		{"safer falls back to lowsec if there is no highsec route", 4, pb.GetRouteRequest_SAFER, []int64{7, 3}, []int64{1, 5, 6, 4}, codes.OK},
+		{"avoided destination is still reached", 4, pb.GetRouteRequest_SHORTEST, []int64{4}, []int64{1, 7, 4}, codes.OK},
+		{"avoiding every path", 4, pb.GetRouteRequest_SHORTEST, []int64{7, 5, 2}, nil, codes.NotFound},
+		{"unconnected destination", 9, pb.GetRouteRequest_SHORTEST, nil, nil, codes.NotFound},
+		{"unknown destination", 10, pb.GetRouteRequest_SHORTEST, nil, nil, codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := GetRoute(context.Background(), &pb.GetRouteRequest{
+				OriginId:      1,
+				DestinationId: test.destinationID,
+				Preference:    test.preference,
+				AvoidIds:      test.avoidIDs,
+			})
+			if status.Code(err) != test.code {
+				t.Fatalf("expected code %s, got %v", test.code, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(response.SystemIds, test.route) {
+				t.Errorf("expected route %v, got %v", test.route, response.SystemIds)
+			}
+
+			if response.Jumps != int32(len(test.route)-1) {
+				t.Errorf("expected %d jumps, got %d", len(test.route)-1, response.Jumps)
+			}
+		})
+	}
+}
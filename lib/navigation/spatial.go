@@ -0,0 +1,73 @@
+package navigation
+
+import "math"
+
+// Meters per light year
+const metersPerLightYear = 9460730472580800
+
+// Edge length of the spatial index's cells in light years, roughly the range of a jump drive
+const spatialIndexCellSize = 5
+
+// Position of a cell in the spatial index's grid
+type cellKey struct {
+	x, y, z int64
+}
+
+// spatialIndex is a uniform grid of solar systems for querying systems in range of a point.
+type spatialIndex struct {
+	cellSize float64
+	cells    map[cellKey][]*SystemNode
+}
+
+func newSpatialIndex(cellSizeLightYears float64) *spatialIndex {
+	return &spatialIndex{
+		cellSize: cellSizeLightYears * metersPerLightYear,
+		cells:    make(map[cellKey][]*SystemNode),
+	}
+}
+
+// Add a system to the index
+func (index *spatialIndex) insert(system *SystemNode) {
+	key := index.cellOf(system.X, system.Y, system.Z)
+	index.cells[key] = append(index.cells[key], system)
+}
+
+// Get all systems within the given range of a system, including the system itself
+func (index *spatialIndex) inRange(origin *SystemNode, lightYears float64) []*SystemNode {
+	var systems []*SystemNode
+
+	center := index.cellOf(origin.X, origin.Y, origin.Z)
+	radius := int64(math.Ceil(lightYears * metersPerLightYear / index.cellSize))
+
+	for x := center.x - radius; x <= center.x+radius; x++ {
+		for y := center.y - radius; y <= center.y+radius; y++ {
+			for z := center.z - radius; z <= center.z+radius; z++ {
+				for _, system := range index.cells[cellKey{x, y, z}] {
+					if distance(origin, system) <= lightYears {
+						systems = append(systems, system)
+					}
+				}
+			}
+		}
+	}
+
+	return systems
+}
+
+// Get the cell a point is located in
+func (index *spatialIndex) cellOf(x, y, z float64) cellKey {
+	return cellKey{
+		x: int64(math.Floor(x / index.cellSize)),
+		y: int64(math.Floor(y / index.cellSize)),
+		z: int64(math.Floor(z / index.cellSize)),
+	}
+}
+
+// Get the direct distance between two systems in light years
+func distance(from *SystemNode, to *SystemNode) float64 {
+	dx := from.X - to.X
+	dy := from.Y - to.Y
+	dz := from.Z - to.Z
+
+	return math.Sqrt(dx*dx+dy*dy+dz*dz) / metersPerLightYear
+}
@@ -0,0 +1,29 @@
+package navigation
+
+//
+// Own types
+//
+
+// CachedGraph represents the stargate graph's cache entry with expiration date.
+//easyjson:json
+type CachedGraph struct {
+	Systems   []SystemNode `json:"systems"`
+	ExpiresAt int64        `json:"expiresAt"`
+	// Graphs stored by older versions are crawled again on start
+	Version int `json:"version"`
+}
+
+// SystemNode is a solar system in the stargate graph.
+//easyjson:json
+type SystemNode struct {
+	ID             int64   `json:"id"`
+	SecurityStatus float64 `json:"securityStatus"`
+	// Systems connected via stargate
+	Neighbours []int64 `json:"neighbours"`
+	// Coordinates in meters
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	// Used for detecting regions jump drives can't be used in
+	RegionID int64 `json:"regionId"`
+}
@@ -2,22 +2,129 @@ package server
 
 import (
 	"context"
+	"crypto/subtle"
+	"strings"
 
 	"github.com/EVE-Tools/static-data/lib/locations"
+	"github.com/EVE-Tools/static-data/lib/navigation"
 	pb "github.com/EVE-Tools/static-data/lib/staticData"
 	"github.com/EVE-Tools/static-data/lib/types"
 	google_pb "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // Server is the gRPC server of this service
-type Server struct{}
+type Server struct {
+	// Maps admins' names onto the tokens they authenticate admin RPCs with, admin RPCs are disabled if empty
+	AdminTokens map[string]string
+}
 
 // GetLocations returns location info for a given list of location IDs
 func (server *Server) GetLocations(context context.Context, request *pb.GetLocationsRequest) (*pb.GetLocationsResponse, error) {
 	return locations.GetLocations(context, request)
 }
 
+// StreamLocations streams location info for a given list of location IDs as it is resolved
+func (server *Server) StreamLocations(request *pb.GetLocationsRequest, stream pb.StaticData_StreamLocationsServer) error {
+	return locations.StreamLocations(request, stream)
+}
+
+// SearchLocations returns cached locations matching a name
+func (server *Server) SearchLocations(context context.Context, request *pb.SearchLocationsRequest) (*pb.SearchLocationsResponse, error) {
+	return locations.SearchLocations(context, request)
+}
+
+// ListChildren returns the direct children of a region, constellation or solar system
+func (server *Server) ListChildren(context context.Context, request *pb.ListChildrenRequest) (*pb.ListLocationsResponse, error) {
+	return locations.ListChildren(context, request)
+}
+
+// ListStationsInRegion returns all stations and structures in a region
+func (server *Server) ListStationsInRegion(context context.Context, request *pb.ListStationsInRegionRequest) (*pb.ListLocationsResponse, error) {
+	return locations.ListStationsInRegion(context, request)
+}
+
+// FindNearby returns stations, structures or celestials near a location
+func (server *Server) FindNearby(context context.Context, request *pb.FindNearbyRequest) (*pb.FindNearbyResponse, error) {
+	return locations.FindNearby(context, request)
+}
+
+// GetStructureHistory returns the recorded attribute changes of structures
+func (server *Server) GetStructureHistory(context context.Context, request *pb.GetStructureHistoryRequest) (*pb.GetStructureHistoryResponse, error) {
+	return locations.GetStructureHistory(context, request)
+}
+
+// UpsertStructureOverride creates or replaces a structure's override, requires an admin token
+func (server *Server) UpsertStructureOverride(context context.Context, request *pb.UpsertStructureOverrideRequest) (*pb.StructureOverride, error) {
+	admin, err := server.authorizeAdmin(context)
+	if err != nil {
+		return nil, err
+	}
+
+	return locations.UpsertStructureOverride(context, admin, request)
+}
+
+// DeleteStructureOverride deletes a structure's override, requires an admin token
+func (server *Server) DeleteStructureOverride(context context.Context, request *pb.DeleteStructureOverrideRequest) (*google_pb.Empty, error) {
+	admin, err := server.authorizeAdmin(context)
+	if err != nil {
+		return nil, err
+	}
+
+	return locations.DeleteStructureOverride(context, admin, request)
+}
+
+// ListStructureOverrides returns all structure overrides, requires an admin token
+func (server *Server) ListStructureOverrides(context context.Context, request *google_pb.Empty) (*pb.ListStructureOverridesResponse, error) {
+	_, err := server.authorizeAdmin(context)
+	if err != nil {
+		return nil, err
+	}
+
+	return locations.ListStructureOverrides(context, request)
+}
+
+// GetRoute returns the route between two solar systems
+func (server *Server) GetRoute(context context.Context, request *pb.GetRouteRequest) (*pb.GetRouteResponse, error) {
+	return navigation.GetRoute(context, request)
+}
+
+// GetDistance returns the distance between two solar systems in light years
+func (server *Server) GetDistance(context context.Context, request *pb.GetDistanceRequest) (*pb.GetDistanceResponse, error) {
+	return navigation.GetDistance(context, request)
+}
+
+// GetSystemsInJumpRange returns all systems which can be reached by a single jump drive jump
+func (server *Server) GetSystemsInJumpRange(context context.Context, request *pb.GetSystemsInJumpRangeRequest) (*pb.GetSystemsInJumpRangeResponse, error) {
+	return navigation.GetSystemsInJumpRange(context, request)
+}
+
 // GetMarketTypes returns all market type IDs from cache
 func (server *Server) GetMarketTypes(context context.Context, empty *google_pb.Empty) (*pb.GetMarketTypesResponse, error) {
 	return types.GetMarketTypes(context, empty)
 }
+
+// Check the admin token passed as "authorization: Bearer <token>" metadata, returns the name of the admin it belongs to
+func (server *Server) authorizeAdmin(context context.Context) (string, error) {
+	if len(server.AdminTokens) == 0 {
+		return "", status.Error(codes.Unimplemented, "Admin RPCs are disabled")
+	}
+
+	md, ok := metadata.FromIncomingContext(context)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "Admin token required")
+	}
+
+	for _, value := range md["authorization"] {
+		token := strings.TrimPrefix(value, "Bearer ")
+		for admin, adminToken := range server.AdminTokens {
+			if adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+				return admin, nil
+			}
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "Invalid admin token")
+}
@@ -10,12 +10,39 @@ It is generated from these files:
 It has these top-level messages:
 	GetLocationsRequest
 	GetLocationsResponse
+	LocationFailure
+	SearchLocationsRequest
+	SearchLocationsResponse
+	SearchResult
+	ListChildrenRequest
+	ListStationsInRegionRequest
+	ListLocationsResponse
+	FindNearbyRequest
+	FindNearbyResponse
+	NearbyLocation
+	GetStructureHistoryRequest
+	GetStructureHistoryResponse
+	StructureHistory
+	StructureSnapshot
+	StructureOverride
+	OverrideAuditEntry
+	UpsertStructureOverrideRequest
+	DeleteStructureOverrideRequest
+	ListStructureOverridesResponse
 	Location
+	Celestial
 	Station
 	Coordinates
 	SolarSystem
 	Constellation
 	Region
+	GetRouteRequest
+	GetRouteResponse
+	GetDistanceRequest
+	GetDistanceResponse
+	GetSystemsInJumpRangeRequest
+	GetSystemsInJumpRangeResponse
+	SystemDistance
 	GetMarketTypesResponse
 */
 package staticData
@@ -43,6 +70,296 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type LocationKind int32
+
+const (
+	LocationKind_ANY           LocationKind = 0
+	LocationKind_REGION        LocationKind = 1
+	LocationKind_CONSTELLATION LocationKind = 2
+	LocationKind_SOLAR_SYSTEM  LocationKind = 3
+	LocationKind_STATION       LocationKind = 4
+	LocationKind_STRUCTURE     LocationKind = 5
+	LocationKind_CELESTIAL     LocationKind = 6
+)
+
+var LocationKind_name = map[int32]string{
+	0: "ANY",
+	1: "REGION",
+	2: "CONSTELLATION",
+	3: "SOLAR_SYSTEM",
+	4: "STATION",
+	5: "STRUCTURE",
+	6: "CELESTIAL",
+}
+var LocationKind_value = map[string]int32{
+	"ANY":           0,
+	"REGION":        1,
+	"CONSTELLATION": 2,
+	"SOLAR_SYSTEM":  3,
+	"STATION":       4,
+	"STRUCTURE":     5,
+	"CELESTIAL":     6,
+}
+
+func (x LocationKind) String() string {
+	return proto.EnumName(LocationKind_name, int32(x))
+}
+func (LocationKind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type LocationFailure_Reason int32
+
+const (
+	LocationFailure_UNKNOWN           LocationFailure_Reason = 0
+	LocationFailure_INVALID_RANGE     LocationFailure_Reason = 1
+	LocationFailure_UNKNOWN_STRUCTURE LocationFailure_Reason = 2
+	LocationFailure_UPSTREAM_ERROR    LocationFailure_Reason = 3
+	LocationFailure_UPSTREAM_TIMEOUT  LocationFailure_Reason = 4
+)
+
+var LocationFailure_Reason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "INVALID_RANGE",
+	2: "UNKNOWN_STRUCTURE",
+	3: "UPSTREAM_ERROR",
+	4: "UPSTREAM_TIMEOUT",
+}
+var LocationFailure_Reason_value = map[string]int32{
+	"UNKNOWN":           0,
+	"INVALID_RANGE":     1,
+	"UNKNOWN_STRUCTURE": 2,
+	"UPSTREAM_ERROR":    3,
+	"UPSTREAM_TIMEOUT":  4,
+}
+
+func (x LocationFailure_Reason) String() string {
+	return proto.EnumName(LocationFailure_Reason_name, int32(x))
+}
+func (LocationFailure_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+type StructureOverride_Visibility int32
+
+const (
+	// Keep the structure feed's value
+	StructureOverride_KEEP    StructureOverride_Visibility = 0
+	StructureOverride_PUBLIC  StructureOverride_Visibility = 1
+	StructureOverride_PRIVATE StructureOverride_Visibility = 2
+)
+
+var StructureOverride_Visibility_name = map[int32]string{
+	0: "KEEP",
+	1: "PUBLIC",
+	2: "PRIVATE",
+}
+var StructureOverride_Visibility_value = map[string]int32{
+	"KEEP":    0,
+	"PUBLIC":  1,
+	"PRIVATE": 2,
+}
+
+func (x StructureOverride_Visibility) String() string {
+	return proto.EnumName(StructureOverride_Visibility_name, int32(x))
+}
+func (StructureOverride_Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{16, 0}
+}
+
+type OverrideAuditEntry_Action int32
+
+const (
+	OverrideAuditEntry_UPSERT OverrideAuditEntry_Action = 0
+	OverrideAuditEntry_DELETE OverrideAuditEntry_Action = 1
+)
+
+var OverrideAuditEntry_Action_name = map[int32]string{
+	0: "UPSERT",
+	1: "DELETE",
+}
+var OverrideAuditEntry_Action_value = map[string]int32{
+	"UPSERT": 0,
+	"DELETE": 1,
+}
+
+func (x OverrideAuditEntry_Action) String() string {
+	return proto.EnumName(OverrideAuditEntry_Action_name, int32(x))
+}
+func (OverrideAuditEntry_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{17, 0}
+}
+
+type Celestial_Kind int32
+
+const (
+	Celestial_UNKNOWN       Celestial_Kind = 0
+	Celestial_PLANET        Celestial_Kind = 1
+	Celestial_MOON          Celestial_Kind = 2
+	Celestial_ASTEROID_BELT Celestial_Kind = 3
+	Celestial_STARGATE      Celestial_Kind = 4
+)
+
+var Celestial_Kind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PLANET",
+	2: "MOON",
+	3: "ASTEROID_BELT",
+	4: "STARGATE",
+}
+var Celestial_Kind_value = map[string]int32{
+	"UNKNOWN":       0,
+	"PLANET":        1,
+	"MOON":          2,
+	"ASTEROID_BELT": 3,
+	"STARGATE":      4,
+}
+
+func (x Celestial_Kind) String() string {
+	return proto.EnumName(Celestial_Kind_name, int32(x))
+}
+func (Celestial_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 0} }
+
+type Station_Status int32
+
+const (
+	// Cached by an older version of the service
+	Station_UNKNOWN Station_Status = 0
+	Station_LIVE    Station_Status = 1
+	// The structure disappeared from the structure feed (destroyed, unanchored...)
+	Station_REMOVED Station_Status = 2
+	// The structure was hidden by an override
+	Station_SUPPRESSED Station_Status = 3
+)
+
+var Station_Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "LIVE",
+	2: "REMOVED",
+	3: "SUPPRESSED",
+}
+var Station_Status_value = map[string]int32{
+	"UNKNOWN":    0,
+	"LIVE":       1,
+	"REMOVED":    2,
+	"SUPPRESSED": 3,
+}
+
+func (x Station_Status) String() string {
+	return proto.EnumName(Station_Status_name, int32(x))
+}
+func (Station_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{23, 0} }
+
+type SolarSystem_WormholeClass int32
+
+const (
+	SolarSystem_NOT_WORMHOLE SolarSystem_WormholeClass = 0
+	SolarSystem_C1           SolarSystem_WormholeClass = 1
+	SolarSystem_C2           SolarSystem_WormholeClass = 2
+	SolarSystem_C3           SolarSystem_WormholeClass = 3
+	SolarSystem_C4           SolarSystem_WormholeClass = 4
+	SolarSystem_C5           SolarSystem_WormholeClass = 5
+	SolarSystem_C6           SolarSystem_WormholeClass = 6
+	SolarSystem_THERA        SolarSystem_WormholeClass = 7
+	SolarSystem_SHATTERED    SolarSystem_WormholeClass = 8
+	SolarSystem_DRIFTER      SolarSystem_WormholeClass = 9
+)
+
+var SolarSystem_WormholeClass_name = map[int32]string{
+	0: "NOT_WORMHOLE",
+	1: "C1",
+	2: "C2",
+	3: "C3",
+	4: "C4",
+	5: "C5",
+	6: "C6",
+	7: "THERA",
+	8: "SHATTERED",
+	9: "DRIFTER",
+}
+var SolarSystem_WormholeClass_value = map[string]int32{
+	"NOT_WORMHOLE": 0,
+	"C1":           1,
+	"C2":           2,
+	"C3":           3,
+	"C4":           4,
+	"C5":           5,
+	"C6":           6,
+	"THERA":        7,
+	"SHATTERED":    8,
+	"DRIFTER":      9,
+}
+
+func (x SolarSystem_WormholeClass) String() string {
+	return proto.EnumName(SolarSystem_WormholeClass_name, int32(x))
+}
+func (SolarSystem_WormholeClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{25, 0}
+}
+
+type SolarSystem_WormholeEffect int32
+
+const (
+	SolarSystem_UNKNOWN_EFFECT       SolarSystem_WormholeEffect = 0
+	SolarSystem_PULSAR               SolarSystem_WormholeEffect = 1
+	SolarSystem_MAGNETAR             SolarSystem_WormholeEffect = 2
+	SolarSystem_BLACK_HOLE           SolarSystem_WormholeEffect = 3
+	SolarSystem_WOLF_RAYET           SolarSystem_WormholeEffect = 4
+	SolarSystem_CATACLYSMIC_VARIABLE SolarSystem_WormholeEffect = 5
+	SolarSystem_RED_GIANT            SolarSystem_WormholeEffect = 6
+	SolarSystem_NO_EFFECT            SolarSystem_WormholeEffect = 7
+)
+
+var SolarSystem_WormholeEffect_name = map[int32]string{
+	0: "UNKNOWN_EFFECT",
+	1: "PULSAR",
+	2: "MAGNETAR",
+	3: "BLACK_HOLE",
+	4: "WOLF_RAYET",
+	5: "CATACLYSMIC_VARIABLE",
+	6: "RED_GIANT",
+	7: "NO_EFFECT",
+}
+var SolarSystem_WormholeEffect_value = map[string]int32{
+	"UNKNOWN_EFFECT":       0,
+	"PULSAR":               1,
+	"MAGNETAR":             2,
+	"BLACK_HOLE":           3,
+	"WOLF_RAYET":           4,
+	"CATACLYSMIC_VARIABLE": 5,
+	"RED_GIANT":            6,
+	"NO_EFFECT":            7,
+}
+
+func (x SolarSystem_WormholeEffect) String() string {
+	return proto.EnumName(SolarSystem_WormholeEffect_name, int32(x))
+}
+func (SolarSystem_WormholeEffect) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{25, 1}
+}
+
+type GetRouteRequest_Preference int32
+
+const (
+	GetRouteRequest_SHORTEST    GetRouteRequest_Preference = 0
+	GetRouteRequest_SAFER       GetRouteRequest_Preference = 1
+	GetRouteRequest_LESS_SECURE GetRouteRequest_Preference = 2
+)
+
+var GetRouteRequest_Preference_name = map[int32]string{
+	0: "SHORTEST",
+	1: "SAFER",
+	2: "LESS_SECURE",
+}
+var GetRouteRequest_Preference_value = map[string]int32{
+	"SHORTEST":    0,
+	"SAFER":       1,
+	"LESS_SECURE": 2,
+}
+
+func (x GetRouteRequest_Preference) String() string {
+	return proto.EnumName(GetRouteRequest_Preference_name, int32(x))
+}
+func (GetRouteRequest_Preference) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{28, 0}
+}
+
 type GetLocationsRequest struct {
 	// Get data for these location IDs
 	LocationIds []int64 `protobuf:"varint,1,rep,packed,name=location_ids,json=locationIds" json:"location_ids,omitempty"`
@@ -63,6 +380,10 @@ func (m *GetLocationsRequest) GetLocationIds() []int64 {
 type GetLocationsResponse struct {
 	// Locations retrieved
 	Locations map[int64]*Location `protobuf:"bytes,1,rep,name=locations" json:"locations,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Locations which could not be retrieved
+	Failures map[int64]*LocationFailure `protobuf:"bytes,2,rep,name=failures" json:"failures,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// IDs of locations served from an expired cache entry (backend unreachable or refresh pending)
+	StaleLocationIds []int64 `protobuf:"varint,3,rep,packed,name=stale_location_ids,json=staleLocationIds" json:"stale_location_ids,omitempty"`
 }
 
 func (m *GetLocationsResponse) Reset()                    { *m = GetLocationsResponse{} }
@@ -77,307 +398,1714 @@ func (m *GetLocationsResponse) GetLocations() map[int64]*Location {
 	return nil
 }
 
-type Location struct {
-	// Information about a region
-	Region *Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
-	// Information about a constellation
-	Constellation *Constellation `protobuf:"bytes,2,opt,name=constellation" json:"constellation,omitempty"`
-	// Information about a solar system
-	SolarSystem *SolarSystem `protobuf:"bytes,3,opt,name=solar_system,json=solarSystem" json:"solar_system,omitempty"`
-	// Information about a station
-	Station *Station `protobuf:"bytes,4,opt,name=station" json:"station,omitempty"`
-}
-
-func (m *Location) Reset()                    { *m = Location{} }
-func (m *Location) String() string            { return proto.CompactTextString(m) }
-func (*Location) ProtoMessage()               {}
-func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
-
-func (m *Location) GetRegion() *Region {
+func (m *GetLocationsResponse) GetFailures() map[int64]*LocationFailure {
 	if m != nil {
-		return m.Region
+		return m.Failures
 	}
 	return nil
 }
 
-func (m *Location) GetConstellation() *Constellation {
+func (m *GetLocationsResponse) GetStaleLocationIds() []int64 {
 	if m != nil {
-		return m.Constellation
+		return m.StaleLocationIds
 	}
 	return nil
 }
 
-func (m *Location) GetSolarSystem() *SolarSystem {
+type LocationFailure struct {
+	// Why the location could not be retrieved
+	Reason LocationFailure_Reason `protobuf:"varint,1,opt,name=reason,enum=staticData.LocationFailure_Reason" json:"reason,omitempty"`
+	// Human-readable description of the failure
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *LocationFailure) Reset()                    { *m = LocationFailure{} }
+func (m *LocationFailure) String() string            { return proto.CompactTextString(m) }
+func (*LocationFailure) ProtoMessage()               {}
+func (*LocationFailure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *LocationFailure) GetReason() LocationFailure_Reason {
 	if m != nil {
-		return m.SolarSystem
+		return m.Reason
 	}
-	return nil
+	return LocationFailure_UNKNOWN
 }
 
-func (m *Location) GetStation() *Station {
+func (m *LocationFailure) GetMessage() string {
 	if m != nil {
-		return m.Station
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-type Station struct {
-	// The station's ID
-	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	// The station's name
-	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	// The station's typeID (only for structures)
-	TypeId int64 `protobuf:"varint,3,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
-	// The station type's name (only for structures)
-	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName" json:"type_name,omitempty"`
-	// When the station was last seen (only for structures)
-	LastSeen *google_protobuf2.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen" json:"last_seen,omitempty"`
-	// Whether this station is public (only for structures)
-	Public bool `protobuf:"varint,6,opt,name=public" json:"public,omitempty"`
-	// When the station was first seen (only for structures)
-	FirstSeen *google_protobuf2.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
-	// The station's coordinates (only for structures)
-	Coordinates *Coordinates `protobuf:"bytes,8,opt,name=coordinates" json:"coordinates,omitempty"`
+type SearchLocationsRequest struct {
+	// Text to search for in the locations' names, case-insensitive
+	Query string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	// Also return names which are similar to the query (typos...)
+	Fuzzy bool `protobuf:"varint,2,opt,name=fuzzy" json:"fuzzy,omitempty"`
+	// Only return locations of these kinds, all kinds if empty
+	Kinds []LocationKind `protobuf:"varint,3,rep,packed,name=kinds,enum=staticData.LocationKind" json:"kinds,omitempty"`
+	// Only return locations in this region
+	RegionId int64 `protobuf:"varint,4,opt,name=region_id,json=regionId" json:"region_id,omitempty"`
+	// Maximum number of results, defaults to 50
+	Limit int32 `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	// Only return locations in solar systems of these wormhole classes, all if empty
+	WormholeClasses []SolarSystem_WormholeClass `protobuf:"varint,6,rep,packed,name=wormhole_classes,json=wormholeClasses,enum=staticData.SolarSystem_WormholeClass" json:"wormhole_classes,omitempty"`
+	// Also return structures which disappeared from the structure feed or were suppressed
+	IncludeRemoved bool `protobuf:"varint,7,opt,name=include_removed,json=includeRemoved" json:"include_removed,omitempty"`
+	// Only return locations in solar systems with these wormhole effects, all if empty
+	WormholeEffects []SolarSystem_WormholeEffect `protobuf:"varint,8,rep,packed,name=wormhole_effects,json=wormholeEffects,enum=staticData.SolarSystem_WormholeEffect" json:"wormhole_effects,omitempty"`
 }
 
-func (m *Station) Reset()                    { *m = Station{} }
-func (m *Station) String() string            { return proto.CompactTextString(m) }
-func (*Station) ProtoMessage()               {}
-func (*Station) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (m *SearchLocationsRequest) Reset()                    { *m = SearchLocationsRequest{} }
+func (m *SearchLocationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchLocationsRequest) ProtoMessage()               {}
+func (*SearchLocationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
-func (m *Station) GetId() int64 {
+func (m *SearchLocationsRequest) GetQuery() string {
 	if m != nil {
-		return m.Id
+		return m.Query
 	}
-	return 0
+	return ""
 }
 
-func (m *Station) GetName() string {
+func (m *SearchLocationsRequest) GetFuzzy() bool {
 	if m != nil {
-		return m.Name
+		return m.Fuzzy
 	}
-	return ""
+	return false
 }
 
-func (m *Station) GetTypeId() int64 {
+func (m *SearchLocationsRequest) GetKinds() []LocationKind {
 	if m != nil {
-		return m.TypeId
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *SearchLocationsRequest) GetRegionId() int64 {
+	if m != nil {
+		return m.RegionId
 	}
 	return 0
 }
 
-func (m *Station) GetTypeName() string {
+func (m *SearchLocationsRequest) GetLimit() int32 {
 	if m != nil {
-		return m.TypeName
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *Station) GetLastSeen() *google_protobuf2.Timestamp {
+func (m *SearchLocationsRequest) GetWormholeClasses() []SolarSystem_WormholeClass {
 	if m != nil {
-		return m.LastSeen
+		return m.WormholeClasses
 	}
 	return nil
 }
 
-func (m *Station) GetPublic() bool {
+func (m *SearchLocationsRequest) GetIncludeRemoved() bool {
 	if m != nil {
-		return m.Public
+		return m.IncludeRemoved
 	}
 	return false
 }
 
-func (m *Station) GetFirstSeen() *google_protobuf2.Timestamp {
+func (m *SearchLocationsRequest) GetWormholeEffects() []SolarSystem_WormholeEffect {
 	if m != nil {
-		return m.FirstSeen
+		return m.WormholeEffects
 	}
 	return nil
 }
 
-func (m *Station) GetCoordinates() *Coordinates {
+type SearchLocationsResponse struct {
+	// Matching locations, best matches first
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *SearchLocationsResponse) Reset()                    { *m = SearchLocationsResponse{} }
+func (m *SearchLocationsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchLocationsResponse) ProtoMessage()               {}
+func (*SearchLocationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *SearchLocationsResponse) GetResults() []*SearchResult {
 	if m != nil {
-		return m.Coordinates
+		return m.Results
 	}
 	return nil
 }
 
-type Coordinates struct {
-	// X-Coordinate
-	X float64 `protobuf:"fixed64,1,opt,name=x" json:"x,omitempty"`
-	// Y-Coordinate
-	Y float64 `protobuf:"fixed64,2,opt,name=y" json:"y,omitempty"`
-	// Z-Coordinate
-	Z float64 `protobuf:"fixed64,3,opt,name=z" json:"z,omitempty"`
+type SearchResult struct {
+	// The location's ID
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The location's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// What kind of location this is
+	Kind LocationKind `protobuf:"varint,3,opt,name=kind,enum=staticData.LocationKind" json:"kind,omitempty"`
+	// The region the location is in
+	RegionId int64 `protobuf:"varint,4,opt,name=region_id,json=regionId" json:"region_id,omitempty"`
 }
 
-func (m *Coordinates) Reset()                    { *m = Coordinates{} }
-func (m *Coordinates) String() string            { return proto.CompactTextString(m) }
-func (*Coordinates) ProtoMessage()               {}
-func (*Coordinates) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (m *SearchResult) Reset()                    { *m = SearchResult{} }
+func (m *SearchResult) String() string            { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()               {}
+func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
-func (m *Coordinates) GetX() float64 {
+func (m *SearchResult) GetId() int64 {
 	if m != nil {
-		return m.X
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Coordinates) GetY() float64 {
+func (m *SearchResult) GetName() string {
 	if m != nil {
-		return m.Y
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Coordinates) GetZ() float64 {
+func (m *SearchResult) GetKind() LocationKind {
 	if m != nil {
-		return m.Z
+		return m.Kind
+	}
+	return LocationKind_ANY
+}
+
+func (m *SearchResult) GetRegionId() int64 {
+	if m != nil {
+		return m.RegionId
 	}
 	return 0
 }
 
-type SolarSystem struct {
-	// The system's ID
-	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	// The system's true security status
-	SecurityStatus float64 `protobuf:"fixed64,2,opt,name=security_status,json=securityStatus" json:"security_status,omitempty"`
-	// The system's name
-	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+type ListChildrenRequest struct {
+	// The region, constellation or solar system to list the direct children of
+	ParentId int64 `protobuf:"varint,1,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	// Only return children of these kinds, all kinds if empty
+	Kinds []LocationKind `protobuf:"varint,2,rep,packed,name=kinds,enum=staticData.LocationKind" json:"kinds,omitempty"`
+	// Only return children in solar systems of these wormhole classes, all if empty
+	WormholeClasses []SolarSystem_WormholeClass `protobuf:"varint,3,rep,packed,name=wormhole_classes,json=wormholeClasses,enum=staticData.SolarSystem_WormholeClass" json:"wormhole_classes,omitempty"`
+	// Also return structures which disappeared from the structure feed or were suppressed
+	IncludeRemoved bool `protobuf:"varint,4,opt,name=include_removed,json=includeRemoved" json:"include_removed,omitempty"`
+	// Only return children in solar systems with these wormhole effects, all if empty
+	WormholeEffects []SolarSystem_WormholeEffect `protobuf:"varint,5,rep,packed,name=wormhole_effects,json=wormholeEffects,enum=staticData.SolarSystem_WormholeEffect" json:"wormhole_effects,omitempty"`
 }
 
-func (m *SolarSystem) Reset()                    { *m = SolarSystem{} }
-func (m *SolarSystem) String() string            { return proto.CompactTextString(m) }
-func (*SolarSystem) ProtoMessage()               {}
-func (*SolarSystem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *ListChildrenRequest) Reset()                    { *m = ListChildrenRequest{} }
+func (m *ListChildrenRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListChildrenRequest) ProtoMessage()               {}
+func (*ListChildrenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
-func (m *SolarSystem) GetId() int64 {
+func (m *ListChildrenRequest) GetParentId() int64 {
 	if m != nil {
-		return m.Id
+		return m.ParentId
 	}
 	return 0
 }
 
-func (m *SolarSystem) GetSecurityStatus() float64 {
+func (m *ListChildrenRequest) GetKinds() []LocationKind {
 	if m != nil {
-		return m.SecurityStatus
+		return m.Kinds
 	}
-	return 0
+	return nil
 }
 
-func (m *SolarSystem) GetName() string {
+func (m *ListChildrenRequest) GetWormholeClasses() []SolarSystem_WormholeClass {
 	if m != nil {
-		return m.Name
+		return m.WormholeClasses
 	}
-	return ""
-}
-
-type Constellation struct {
-	// The constellation's id
-	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	// The constellation's name
-	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	return nil
 }
 
-func (m *Constellation) Reset()                    { *m = Constellation{} }
-func (m *Constellation) String() string            { return proto.CompactTextString(m) }
-func (*Constellation) ProtoMessage()               {}
-func (*Constellation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
-
-func (m *Constellation) GetId() int64 {
+func (m *ListChildrenRequest) GetIncludeRemoved() bool {
 	if m != nil {
-		return m.Id
+		return m.IncludeRemoved
 	}
-	return 0
+	return false
 }
 
-func (m *Constellation) GetName() string {
+func (m *ListChildrenRequest) GetWormholeEffects() []SolarSystem_WormholeEffect {
 	if m != nil {
-		return m.Name
+		return m.WormholeEffects
 	}
-	return ""
+	return nil
 }
 
-type Region struct {
-	// The region's id
-	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	// The region's name
-	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+type ListStationsInRegionRequest struct {
+	// The region to list stations and structures of
+	RegionId int64 `protobuf:"varint,1,opt,name=region_id,json=regionId" json:"region_id,omitempty"`
+	// Only return public stations and structures
+	PublicOnly bool `protobuf:"varint,2,opt,name=public_only,json=publicOnly" json:"public_only,omitempty"`
+	// Also return structures which disappeared from the structure feed or were suppressed
+	IncludeRemoved bool `protobuf:"varint,3,opt,name=include_removed,json=includeRemoved" json:"include_removed,omitempty"`
 }
 
-func (m *Region) Reset()                    { *m = Region{} }
-func (m *Region) String() string            { return proto.CompactTextString(m) }
-func (*Region) ProtoMessage()               {}
-func (*Region) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (m *ListStationsInRegionRequest) Reset()                    { *m = ListStationsInRegionRequest{} }
+func (m *ListStationsInRegionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListStationsInRegionRequest) ProtoMessage()               {}
+func (*ListStationsInRegionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
-func (m *Region) GetId() int64 {
+func (m *ListStationsInRegionRequest) GetRegionId() int64 {
 	if m != nil {
-		return m.Id
+		return m.RegionId
 	}
 	return 0
 }
 
-func (m *Region) GetName() string {
+func (m *ListStationsInRegionRequest) GetPublicOnly() bool {
 	if m != nil {
-		return m.Name
+		return m.PublicOnly
 	}
-	return ""
+	return false
 }
 
-type GetMarketTypesResponse struct {
-	// Locations retrieved
-	TypeIds []int32 `protobuf:"varint,1,rep,packed,name=type_ids,json=typeIds" json:"type_ids,omitempty"`
+func (m *ListStationsInRegionRequest) GetIncludeRemoved() bool {
+	if m != nil {
+		return m.IncludeRemoved
+	}
+	return false
 }
 
-func (m *GetMarketTypesResponse) Reset()                    { *m = GetMarketTypesResponse{} }
-func (m *GetMarketTypesResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetMarketTypesResponse) ProtoMessage()               {}
-func (*GetMarketTypesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+type ListLocationsResponse struct {
+	// Cached locations matching the request
+	Locations map[int64]*Location `protobuf:"bytes,1,rep,name=locations" json:"locations,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
 
-func (m *GetMarketTypesResponse) GetTypeIds() []int32 {
+func (m *ListLocationsResponse) Reset()                    { *m = ListLocationsResponse{} }
+func (m *ListLocationsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListLocationsResponse) ProtoMessage()               {}
+func (*ListLocationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ListLocationsResponse) GetLocations() map[int64]*Location {
 	if m != nil {
-		return m.TypeIds
+		return m.Locations
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetLocationsRequest)(nil), "staticData.GetLocationsRequest")
-	proto.RegisterType((*GetLocationsResponse)(nil), "staticData.GetLocationsResponse")
-	proto.RegisterType((*Location)(nil), "staticData.Location")
-	proto.RegisterType((*Station)(nil), "staticData.Station")
-	proto.RegisterType((*Coordinates)(nil), "staticData.Coordinates")
-	proto.RegisterType((*SolarSystem)(nil), "staticData.SolarSystem")
-	proto.RegisterType((*Constellation)(nil), "staticData.Constellation")
-	proto.RegisterType((*Region)(nil), "staticData.Region")
-	proto.RegisterType((*GetMarketTypesResponse)(nil), "staticData.GetMarketTypesResponse")
+type FindNearbyRequest struct {
+	// The station, structure, celestial or solar system to search around
+	OriginId int64 `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	// Point in the origin solar system to search around, only used if origin_id is a solar system
+	Coordinates *Coordinates `protobuf:"bytes,2,opt,name=coordinates" json:"coordinates,omitempty"`
+	// Only return locations of these kinds (stations, structures or celestials), stations and structures if empty
+	Kinds []LocationKind `protobuf:"varint,3,rep,packed,name=kinds,enum=staticData.LocationKind" json:"kinds,omitempty"`
+	// Only return public stations and structures
+	PublicOnly bool `protobuf:"varint,4,opt,name=public_only,json=publicOnly" json:"public_only,omitempty"`
+	// Maximum distance in AU within the origin's solar system, unlimited if 0. Requires coordinates if origin_id is a
+	// solar system
+	MaxDistanceAu float64 `protobuf:"fixed64,5,opt,name=max_distance_au,json=maxDistanceAu" json:"max_distance_au,omitempty"`
+	// Also search solar systems up to this many jumps away (at most 10)
+	MaxJumps int32 `protobuf:"varint,6,opt,name=max_jumps,json=maxJumps" json:"max_jumps,omitempty"`
+	// Maximum number of results, defaults to 50
+	Limit int32 `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+	// Also return structures which disappeared from the structure feed or were suppressed
+	IncludeRemoved bool `protobuf:"varint,8,opt,name=include_removed,json=includeRemoved" json:"include_removed,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
+func (m *FindNearbyRequest) Reset()                    { *m = FindNearbyRequest{} }
+func (m *FindNearbyRequest) String() string            { return proto.CompactTextString(m) }
+func (*FindNearbyRequest) ProtoMessage()               {}
+func (*FindNearbyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *FindNearbyRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *FindNearbyRequest) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *FindNearbyRequest) GetKinds() []LocationKind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *FindNearbyRequest) GetPublicOnly() bool {
+	if m != nil {
+		return m.PublicOnly
+	}
+	return false
+}
+
+func (m *FindNearbyRequest) GetMaxDistanceAu() float64 {
+	if m != nil {
+		return m.MaxDistanceAu
+	}
+	return 0
+}
+
+func (m *FindNearbyRequest) GetMaxJumps() int32 {
+	if m != nil {
+		return m.MaxJumps
+	}
+	return 0
+}
+
+func (m *FindNearbyRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FindNearbyRequest) GetIncludeRemoved() bool {
+	if m != nil {
+		return m.IncludeRemoved
+	}
+	return false
+}
+
+type FindNearbyResponse struct {
+	// Matching locations, nearest first
+	Results []*NearbyLocation `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *FindNearbyResponse) Reset()                    { *m = FindNearbyResponse{} }
+func (m *FindNearbyResponse) String() string            { return proto.CompactTextString(m) }
+func (*FindNearbyResponse) ProtoMessage()               {}
+func (*FindNearbyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *FindNearbyResponse) GetResults() []*NearbyLocation {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type NearbyLocation struct {
+	// The location's ID
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// What kind of location this is
+	Kind LocationKind `protobuf:"varint,2,opt,name=kind,enum=staticData.LocationKind" json:"kind,omitempty"`
+	// Number of jumps from the origin's solar system
+	Jumps int32 `protobuf:"varint,3,opt,name=jumps" json:"jumps,omitempty"`
+	// Distance to the origin in AU (only for locations in the origin's solar system)
+	DistanceAu float64 `protobuf:"fixed64,4,opt,name=distance_au,json=distanceAu" json:"distance_au,omitempty"`
+	// The location
+	Location *Location `protobuf:"bytes,5,opt,name=location" json:"location,omitempty"`
+}
+
+func (m *NearbyLocation) Reset()                    { *m = NearbyLocation{} }
+func (m *NearbyLocation) String() string            { return proto.CompactTextString(m) }
+func (*NearbyLocation) ProtoMessage()               {}
+func (*NearbyLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *NearbyLocation) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NearbyLocation) GetKind() LocationKind {
+	if m != nil {
+		return m.Kind
+	}
+	return LocationKind_ANY
+}
+
+func (m *NearbyLocation) GetJumps() int32 {
+	if m != nil {
+		return m.Jumps
+	}
+	return 0
+}
+
+func (m *NearbyLocation) GetDistanceAu() float64 {
+	if m != nil {
+		return m.DistanceAu
+	}
+	return 0
+}
+
+func (m *NearbyLocation) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+type GetStructureHistoryRequest struct {
+	// Get the history of these structure IDs
+	StructureIds []int64 `protobuf:"varint,1,rep,packed,name=structure_ids,json=structureIds" json:"structure_ids,omitempty"`
+}
+
+func (m *GetStructureHistoryRequest) Reset()                    { *m = GetStructureHistoryRequest{} }
+func (m *GetStructureHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetStructureHistoryRequest) ProtoMessage()               {}
+func (*GetStructureHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *GetStructureHistoryRequest) GetStructureIds() []int64 {
+	if m != nil {
+		return m.StructureIds
+	}
+	return nil
+}
+
+type GetStructureHistoryResponse struct {
+	// Histories indexed by structure ID, structures without recorded history are omitted
+	Histories map[int64]*StructureHistory `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *GetStructureHistoryResponse) Reset()                    { *m = GetStructureHistoryResponse{} }
+func (m *GetStructureHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetStructureHistoryResponse) ProtoMessage()               {}
+func (*GetStructureHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *GetStructureHistoryResponse) GetHistories() map[int64]*StructureHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+type StructureHistory struct {
+	// Snapshots of the structure's attributes, oldest first
+	Snapshots []*StructureSnapshot `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+}
+
+func (m *StructureHistory) Reset()                    { *m = StructureHistory{} }
+func (m *StructureHistory) String() string            { return proto.CompactTextString(m) }
+func (*StructureHistory) ProtoMessage()               {}
+func (*StructureHistory) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *StructureHistory) GetSnapshots() []*StructureSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type StructureSnapshot struct {
+	// When the attributes were first observed
+	ObservedAt *google_protobuf2.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt" json:"observed_at,omitempty"`
+	// The structure's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The structure's typeID
+	TypeId int64 `protobuf:"varint,3,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// The structure type's name
+	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName" json:"type_name,omitempty"`
+	// Whether the structure was public
+	Public bool `protobuf:"varint,5,opt,name=public" json:"public,omitempty"`
+	// The structure's solar system
+	SolarSystemId int64 `protobuf:"varint,6,opt,name=solar_system_id,json=solarSystemId" json:"solar_system_id,omitempty"`
+	// Whether the structure still existed
+	Status Station_Status `protobuf:"varint,7,opt,name=status,enum=staticData.Station_Status" json:"status,omitempty"`
+}
+
+func (m *StructureSnapshot) Reset()                    { *m = StructureSnapshot{} }
+func (m *StructureSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*StructureSnapshot) ProtoMessage()               {}
+func (*StructureSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *StructureSnapshot) GetObservedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.ObservedAt
+	}
+	return nil
+}
+
+func (m *StructureSnapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StructureSnapshot) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *StructureSnapshot) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *StructureSnapshot) GetPublic() bool {
+	if m != nil {
+		return m.Public
+	}
+	return false
+}
+
+func (m *StructureSnapshot) GetSolarSystemId() int64 {
+	if m != nil {
+		return m.SolarSystemId
+	}
+	return 0
+}
+
+func (m *StructureSnapshot) GetStatus() Station_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Station_UNKNOWN
+}
+
+type StructureOverride struct {
+	// The overridden structure's ID
+	StructureId int64 `protobuf:"varint,1,opt,name=structure_id,json=structureId" json:"structure_id,omitempty"`
+	// Replaces the structure's name if set
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Replaces the structure's typeID if set
+	TypeId int64 `protobuf:"varint,3,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// Replaces the structure type's name if set
+	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName" json:"type_name,omitempty"`
+	// Moves the structure to this solar system if set
+	SolarSystemId int64 `protobuf:"varint,5,opt,name=solar_system_id,json=solarSystemId" json:"solar_system_id,omitempty"`
+	// Replaces the structure's coordinates if set
+	Coordinates *Coordinates `protobuf:"bytes,6,opt,name=coordinates" json:"coordinates,omitempty"`
+	// Replaces whether the structure is public
+	Visibility StructureOverride_Visibility `protobuf:"varint,7,opt,name=visibility,enum=staticData.StructureOverride_Visibility" json:"visibility,omitempty"`
+	// Hide the structure, it is only returned by GetLocations
+	Suppressed bool `protobuf:"varint,8,opt,name=suppressed" json:"suppressed,omitempty"`
+	// Changes of this structure's override, oldest first (ignored on upsert)
+	AuditTrail []*OverrideAuditEntry `protobuf:"bytes,9,rep,name=audit_trail,json=auditTrail" json:"audit_trail,omitempty"`
+}
+
+func (m *StructureOverride) Reset()                    { *m = StructureOverride{} }
+func (m *StructureOverride) String() string            { return proto.CompactTextString(m) }
+func (*StructureOverride) ProtoMessage()               {}
+func (*StructureOverride) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *StructureOverride) GetStructureId() int64 {
+	if m != nil {
+		return m.StructureId
+	}
+	return 0
+}
+
+func (m *StructureOverride) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StructureOverride) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *StructureOverride) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *StructureOverride) GetSolarSystemId() int64 {
+	if m != nil {
+		return m.SolarSystemId
+	}
+	return 0
+}
+
+func (m *StructureOverride) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *StructureOverride) GetVisibility() StructureOverride_Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return StructureOverride_KEEP
+}
+
+func (m *StructureOverride) GetSuppressed() bool {
+	if m != nil {
+		return m.Suppressed
+	}
+	return false
+}
+
+func (m *StructureOverride) GetAuditTrail() []*OverrideAuditEntry {
+	if m != nil {
+		return m.AuditTrail
+	}
+	return nil
+}
+
+type OverrideAuditEntry struct {
+	// Who changed the override
+	Author string `protobuf:"bytes,1,opt,name=author" json:"author,omitempty"`
+	// Why the override was changed
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	// When the override was changed
+	ChangedAt *google_protobuf2.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt" json:"changed_at,omitempty"`
+	// What was done
+	Action OverrideAuditEntry_Action `protobuf:"varint,4,opt,name=action,enum=staticData.OverrideAuditEntry_Action" json:"action,omitempty"`
+}
+
+func (m *OverrideAuditEntry) Reset()                    { *m = OverrideAuditEntry{} }
+func (m *OverrideAuditEntry) String() string            { return proto.CompactTextString(m) }
+func (*OverrideAuditEntry) ProtoMessage()               {}
+func (*OverrideAuditEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *OverrideAuditEntry) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *OverrideAuditEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OverrideAuditEntry) GetChangedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return nil
+}
+
+func (m *OverrideAuditEntry) GetAction() OverrideAuditEntry_Action {
+	if m != nil {
+		return m.Action
+	}
+	return OverrideAuditEntry_UPSERT
+}
+
+type UpsertStructureOverrideRequest struct {
+	// The override to create or replace. Structures missing in the structure feed need at least a name and solar system.
+	Override *StructureOverride `protobuf:"bytes,1,opt,name=override" json:"override,omitempty"`
+	// Why the override is changed
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *UpsertStructureOverrideRequest) Reset()         { *m = UpsertStructureOverrideRequest{} }
+func (m *UpsertStructureOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertStructureOverrideRequest) ProtoMessage()    {}
+func (*UpsertStructureOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{18}
+}
+
+func (m *UpsertStructureOverrideRequest) GetOverride() *StructureOverride {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+func (m *UpsertStructureOverrideRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type DeleteStructureOverrideRequest struct {
+	// The structure whose override is deleted
+	StructureId int64 `protobuf:"varint,1,opt,name=structure_id,json=structureId" json:"structure_id,omitempty"`
+	// Why the override is deleted
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *DeleteStructureOverrideRequest) Reset()         { *m = DeleteStructureOverrideRequest{} }
+func (m *DeleteStructureOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteStructureOverrideRequest) ProtoMessage()    {}
+func (*DeleteStructureOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{19}
+}
+
+func (m *DeleteStructureOverrideRequest) GetStructureId() int64 {
+	if m != nil {
+		return m.StructureId
+	}
+	return 0
+}
+
+func (m *DeleteStructureOverrideRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ListStructureOverridesResponse struct {
+	// All overrides, ordered by structure ID
+	Overrides []*StructureOverride `protobuf:"bytes,1,rep,name=overrides" json:"overrides,omitempty"`
+}
+
+func (m *ListStructureOverridesResponse) Reset()         { *m = ListStructureOverridesResponse{} }
+func (m *ListStructureOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStructureOverridesResponse) ProtoMessage()    {}
+func (*ListStructureOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{20}
+}
+
+func (m *ListStructureOverridesResponse) GetOverrides() []*StructureOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+type Location struct {
+	// Information about a region
+	Region *Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	// Information about a constellation
+	Constellation *Constellation `protobuf:"bytes,2,opt,name=constellation" json:"constellation,omitempty"`
+	// Information about a solar system
+	SolarSystem *SolarSystem `protobuf:"bytes,3,opt,name=solar_system,json=solarSystem" json:"solar_system,omitempty"`
+	// Information about a station
+	Station *Station `protobuf:"bytes,4,opt,name=station" json:"station,omitempty"`
+	// Information about a celestial (planet, moon, asteroid belt or stargate)
+	Celestial *Celestial `protobuf:"bytes,5,opt,name=celestial" json:"celestial,omitempty"`
+}
+
+func (m *Location) Reset()                    { *m = Location{} }
+func (m *Location) String() string            { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()               {}
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *Location) GetRegion() *Region {
+	if m != nil {
+		return m.Region
+	}
+	return nil
+}
+
+func (m *Location) GetConstellation() *Constellation {
+	if m != nil {
+		return m.Constellation
+	}
+	return nil
+}
+
+func (m *Location) GetSolarSystem() *SolarSystem {
+	if m != nil {
+		return m.SolarSystem
+	}
+	return nil
+}
+
+func (m *Location) GetStation() *Station {
+	if m != nil {
+		return m.Station
+	}
+	return nil
+}
+
+func (m *Location) GetCelestial() *Celestial {
+	if m != nil {
+		return m.Celestial
+	}
+	return nil
+}
+
+type Celestial struct {
+	// The celestial's ID
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The celestial's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// What kind of celestial this is
+	Kind Celestial_Kind `protobuf:"varint,3,opt,name=kind,enum=staticData.Celestial_Kind" json:"kind,omitempty"`
+	// The celestial's typeID (only for planets and stargates)
+	TypeId int64 `protobuf:"varint,4,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// The celestial's coordinates
+	Coordinates *Coordinates `protobuf:"bytes,5,opt,name=coordinates" json:"coordinates,omitempty"`
+	// The solar system the stargate leads to (only for stargates)
+	DestinationSystemId int64 `protobuf:"varint,6,opt,name=destination_system_id,json=destinationSystemId" json:"destination_system_id,omitempty"`
+	// The stargate it is connected to (only for stargates)
+	DestinationStargateId int64 `protobuf:"varint,7,opt,name=destination_stargate_id,json=destinationStargateId" json:"destination_stargate_id,omitempty"`
+}
+
+func (m *Celestial) Reset()                    { *m = Celestial{} }
+func (m *Celestial) String() string            { return proto.CompactTextString(m) }
+func (*Celestial) ProtoMessage()               {}
+func (*Celestial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *Celestial) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Celestial) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Celestial) GetKind() Celestial_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Celestial_UNKNOWN
+}
+
+func (m *Celestial) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *Celestial) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *Celestial) GetDestinationSystemId() int64 {
+	if m != nil {
+		return m.DestinationSystemId
+	}
+	return 0
+}
+
+func (m *Celestial) GetDestinationStargateId() int64 {
+	if m != nil {
+		return m.DestinationStargateId
+	}
+	return 0
+}
+
+type Station struct {
+	// The station's ID
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The station's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The station's typeID (only for structures)
+	TypeId int64 `protobuf:"varint,3,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// The station type's name (only for structures)
+	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName" json:"type_name,omitempty"`
+	// When the station was last seen (only for structures)
+	LastSeen *google_protobuf2.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen" json:"last_seen,omitempty"`
+	// Whether this station is public (only for structures)
+	Public bool `protobuf:"varint,6,opt,name=public" json:"public,omitempty"`
+	// When the station was first seen (only for structures)
+	FirstSeen *google_protobuf2.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
+	// The station's coordinates
+	Coordinates *Coordinates `protobuf:"bytes,8,opt,name=coordinates" json:"coordinates,omitempty"`
+	// ID of the corporation owning the station (not known for structures from the structure feed)
+	OwnerId int64 `protobuf:"varint,9,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	// Name of the corporation owning the station (not known for structures from the structure feed)
+	OwnerName string `protobuf:"bytes,10,opt,name=owner_name,json=ownerName" json:"owner_name,omitempty"`
+	// The station's race (only for NPC stations)
+	RaceId int64 `protobuf:"varint,11,opt,name=race_id,json=raceId" json:"race_id,omitempty"`
+	// Services offered by the station (only for NPC stations)
+	Services []string `protobuf:"bytes,12,rep,name=services" json:"services,omitempty"`
+	// The station's reprocessing efficiency (only for NPC stations)
+	ReprocessingEfficiency float64 `protobuf:"fixed64,13,opt,name=reprocessing_efficiency,json=reprocessingEfficiency" json:"reprocessing_efficiency,omitempty"`
+	// Share of reprocessed materials taken by the station (only for NPC stations)
+	ReprocessingStationsTake float64 `protobuf:"fixed64,14,opt,name=reprocessing_stations_take,json=reprocessingStationsTake" json:"reprocessing_stations_take,omitempty"`
+	// Volume of the largest ship that can dock in m³ (only for NPC stations)
+	MaxDockableShipVolume float64 `protobuf:"fixed64,15,opt,name=max_dockable_ship_volume,json=maxDockableShipVolume" json:"max_dockable_ship_volume,omitempty"`
+	// Monthly office rental cost in ISK (only for NPC stations)
+	OfficeRentalCost float64 `protobuf:"fixed64,16,opt,name=office_rental_cost,json=officeRentalCost" json:"office_rental_cost,omitempty"`
+	// Whether the station still exists
+	Status Station_Status `protobuf:"varint,17,opt,name=status,enum=staticData.Station_Status" json:"status,omitempty"`
+	// When the structure disappeared from the structure feed (only for removed structures)
+	RemovedAt *google_protobuf2.Timestamp `protobuf:"bytes,18,opt,name=removed_at,json=removedAt" json:"removed_at,omitempty"`
+}
+
+func (m *Station) Reset()                    { *m = Station{} }
+func (m *Station) String() string            { return proto.CompactTextString(m) }
+func (*Station) ProtoMessage()               {}
+func (*Station) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *Station) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Station) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Station) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *Station) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *Station) GetLastSeen() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.LastSeen
+	}
+	return nil
+}
+
+func (m *Station) GetPublic() bool {
+	if m != nil {
+		return m.Public
+	}
+	return false
+}
+
+func (m *Station) GetFirstSeen() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.FirstSeen
+	}
+	return nil
+}
+
+func (m *Station) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *Station) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
+	}
+	return 0
+}
+
+func (m *Station) GetOwnerName() string {
+	if m != nil {
+		return m.OwnerName
+	}
+	return ""
+}
+
+func (m *Station) GetRaceId() int64 {
+	if m != nil {
+		return m.RaceId
+	}
+	return 0
+}
+
+func (m *Station) GetServices() []string {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *Station) GetReprocessingEfficiency() float64 {
+	if m != nil {
+		return m.ReprocessingEfficiency
+	}
+	return 0
+}
+
+func (m *Station) GetReprocessingStationsTake() float64 {
+	if m != nil {
+		return m.ReprocessingStationsTake
+	}
+	return 0
+}
+
+func (m *Station) GetMaxDockableShipVolume() float64 {
+	if m != nil {
+		return m.MaxDockableShipVolume
+	}
+	return 0
+}
+
+func (m *Station) GetOfficeRentalCost() float64 {
+	if m != nil {
+		return m.OfficeRentalCost
+	}
+	return 0
+}
+
+func (m *Station) GetStatus() Station_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Station_UNKNOWN
+}
+
+func (m *Station) GetRemovedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
+type Coordinates struct {
+	// X-Coordinate
+	X float64 `protobuf:"fixed64,1,opt,name=x" json:"x,omitempty"`
+	// Y-Coordinate
+	Y float64 `protobuf:"fixed64,2,opt,name=y" json:"y,omitempty"`
+	// Z-Coordinate
+	Z float64 `protobuf:"fixed64,3,opt,name=z" json:"z,omitempty"`
+}
+
+func (m *Coordinates) Reset()                    { *m = Coordinates{} }
+func (m *Coordinates) String() string            { return proto.CompactTextString(m) }
+func (*Coordinates) ProtoMessage()               {}
+func (*Coordinates) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *Coordinates) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *Coordinates) GetY() float64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *Coordinates) GetZ() float64 {
+	if m != nil {
+		return m.Z
+	}
+	return 0
+}
+
+type SolarSystem struct {
+	// The system's ID
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The system's true security status
+	SecurityStatus float64 `protobuf:"fixed64,2,opt,name=security_status,json=securityStatus" json:"security_status,omitempty"`
+	// The system's name
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// The system's coordinates
+	Coordinates *Coordinates `protobuf:"bytes,4,opt,name=coordinates" json:"coordinates,omitempty"`
+	// The ID of the system's star
+	StarId int64 `protobuf:"varint,5,opt,name=star_id,json=starId" json:"star_id,omitempty"`
+	// The system's security class
+	SecurityClass string `protobuf:"bytes,6,opt,name=security_class,json=securityClass" json:"security_class,omitempty"`
+	// The IDs of the system's planets
+	PlanetIds []int64 `protobuf:"varint,7,rep,packed,name=planet_ids,json=planetIds" json:"planet_ids,omitempty"`
+	// The system's wormhole class (only for wormhole space)
+	WormholeClass SolarSystem_WormholeClass `protobuf:"varint,8,opt,name=wormhole_class,json=wormholeClass,enum=staticData.SolarSystem_WormholeClass" json:"wormhole_class,omitempty"`
+	// The system's wormhole effect (unknown for wormhole space without SDE import)
+	WormholeEffect SolarSystem_WormholeEffect `protobuf:"varint,9,opt,name=wormhole_effect,json=wormholeEffect,enum=staticData.SolarSystem_WormholeEffect" json:"wormhole_effect,omitempty"`
+	// The ID of the faction the system belongs to (0 if none)
+	FactionId int64 `protobuf:"varint,10,opt,name=faction_id,json=factionId" json:"faction_id,omitempty"`
+	// The IDs of the system's stargates
+	StargateIds []int64 `protobuf:"varint,11,rep,packed,name=stargate_ids,json=stargateIds" json:"stargate_ids,omitempty"`
+}
+
+func (m *SolarSystem) Reset()                    { *m = SolarSystem{} }
+func (m *SolarSystem) String() string            { return proto.CompactTextString(m) }
+func (*SolarSystem) ProtoMessage()               {}
+func (*SolarSystem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *SolarSystem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SolarSystem) GetSecurityStatus() float64 {
+	if m != nil {
+		return m.SecurityStatus
+	}
+	return 0
+}
+
+func (m *SolarSystem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SolarSystem) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *SolarSystem) GetStarId() int64 {
+	if m != nil {
+		return m.StarId
+	}
+	return 0
+}
+
+func (m *SolarSystem) GetSecurityClass() string {
+	if m != nil {
+		return m.SecurityClass
+	}
+	return ""
+}
+
+func (m *SolarSystem) GetPlanetIds() []int64 {
+	if m != nil {
+		return m.PlanetIds
+	}
+	return nil
+}
+
+func (m *SolarSystem) GetWormholeClass() SolarSystem_WormholeClass {
+	if m != nil {
+		return m.WormholeClass
+	}
+	return SolarSystem_NOT_WORMHOLE
+}
+
+func (m *SolarSystem) GetWormholeEffect() SolarSystem_WormholeEffect {
+	if m != nil {
+		return m.WormholeEffect
+	}
+	return SolarSystem_UNKNOWN_EFFECT
+}
+
+func (m *SolarSystem) GetFactionId() int64 {
+	if m != nil {
+		return m.FactionId
+	}
+	return 0
+}
+
+func (m *SolarSystem) GetStargateIds() []int64 {
+	if m != nil {
+		return m.StargateIds
+	}
+	return nil
+}
+
+type Constellation struct {
+	// The constellation's id
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The constellation's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The constellation's coordinates
+	Coordinates *Coordinates `protobuf:"bytes,3,opt,name=coordinates" json:"coordinates,omitempty"`
+	// The ID of the faction the constellation belongs to (0 if none, requires SDE import)
+	FactionId int64 `protobuf:"varint,4,opt,name=faction_id,json=factionId" json:"faction_id,omitempty"`
+}
+
+func (m *Constellation) Reset()                    { *m = Constellation{} }
+func (m *Constellation) String() string            { return proto.CompactTextString(m) }
+func (*Constellation) ProtoMessage()               {}
+func (*Constellation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *Constellation) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Constellation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Constellation) GetCoordinates() *Coordinates {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+func (m *Constellation) GetFactionId() int64 {
+	if m != nil {
+		return m.FactionId
+	}
+	return 0
+}
+
+type Region struct {
+	// The region's id
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The region's name
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The region's description
+	Description string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	// The ID of the faction the region belongs to (0 if none, requires SDE import)
+	FactionId int64 `protobuf:"varint,4,opt,name=faction_id,json=factionId" json:"faction_id,omitempty"`
+}
+
+func (m *Region) Reset()                    { *m = Region{} }
+func (m *Region) String() string            { return proto.CompactTextString(m) }
+func (*Region) ProtoMessage()               {}
+func (*Region) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *Region) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Region) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Region) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Region) GetFactionId() int64 {
+	if m != nil {
+		return m.FactionId
+	}
+	return 0
+}
+
+type GetRouteRequest struct {
+	// The solar system the route starts in
+	OriginId int64 `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	// The solar system the route ends in
+	DestinationId int64 `protobuf:"varint,2,opt,name=destination_id,json=destinationId" json:"destination_id,omitempty"`
+	// Which kind of route to prefer
+	Preference GetRouteRequest_Preference `protobuf:"varint,3,opt,name=preference,enum=staticData.GetRouteRequest_Preference" json:"preference,omitempty"`
+	// Solar systems the route must not pass through
+	AvoidIds []int64 `protobuf:"varint,4,rep,packed,name=avoid_ids,json=avoidIds" json:"avoid_ids,omitempty"`
+}
+
+func (m *GetRouteRequest) Reset()                    { *m = GetRouteRequest{} }
+func (m *GetRouteRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRouteRequest) ProtoMessage()               {}
+func (*GetRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GetRouteRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *GetRouteRequest) GetDestinationId() int64 {
+	if m != nil {
+		return m.DestinationId
+	}
+	return 0
+}
+
+func (m *GetRouteRequest) GetPreference() GetRouteRequest_Preference {
+	if m != nil {
+		return m.Preference
+	}
+	return GetRouteRequest_SHORTEST
+}
+
+func (m *GetRouteRequest) GetAvoidIds() []int64 {
+	if m != nil {
+		return m.AvoidIds
+	}
+	return nil
+}
+
+type GetRouteResponse struct {
+	// IDs of the solar systems on the route, including origin and destination
+	SystemIds []int64 `protobuf:"varint,1,rep,packed,name=system_ids,json=systemIds" json:"system_ids,omitempty"`
+	// Number of jumps needed
+	Jumps int32 `protobuf:"varint,2,opt,name=jumps" json:"jumps,omitempty"`
+}
+
+func (m *GetRouteResponse) Reset()                    { *m = GetRouteResponse{} }
+func (m *GetRouteResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRouteResponse) ProtoMessage()               {}
+func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GetRouteResponse) GetSystemIds() []int64 {
+	if m != nil {
+		return m.SystemIds
+	}
+	return nil
+}
+
+func (m *GetRouteResponse) GetJumps() int32 {
+	if m != nil {
+		return m.Jumps
+	}
+	return 0
+}
+
+type GetDistanceRequest struct {
+	// The solar system to measure from
+	OriginId int64 `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	// The solar system to measure to
+	DestinationId int64 `protobuf:"varint,2,opt,name=destination_id,json=destinationId" json:"destination_id,omitempty"`
+}
+
+func (m *GetDistanceRequest) Reset()                    { *m = GetDistanceRequest{} }
+func (m *GetDistanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDistanceRequest) ProtoMessage()               {}
+func (*GetDistanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GetDistanceRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *GetDistanceRequest) GetDestinationId() int64 {
+	if m != nil {
+		return m.DestinationId
+	}
+	return 0
+}
+
+type GetDistanceResponse struct {
+	// Direct distance between the systems in light years
+	LightYears float64 `protobuf:"fixed64,1,opt,name=light_years,json=lightYears" json:"light_years,omitempty"`
+}
+
+func (m *GetDistanceResponse) Reset()                    { *m = GetDistanceResponse{} }
+func (m *GetDistanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDistanceResponse) ProtoMessage()               {}
+func (*GetDistanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *GetDistanceResponse) GetLightYears() float64 {
+	if m != nil {
+		return m.LightYears
+	}
+	return 0
+}
+
+type GetSystemsInJumpRangeRequest struct {
+	// The solar system to jump from
+	OriginId int64 `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	// The jump drive's range in light years
+	Range float64 `protobuf:"fixed64,2,opt,name=range" json:"range,omitempty"`
+}
+
+func (m *GetSystemsInJumpRangeRequest) Reset()                    { *m = GetSystemsInJumpRangeRequest{} }
+func (m *GetSystemsInJumpRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSystemsInJumpRangeRequest) ProtoMessage()               {}
+func (*GetSystemsInJumpRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GetSystemsInJumpRangeRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *GetSystemsInJumpRangeRequest) GetRange() float64 {
+	if m != nil {
+		return m.Range
+	}
+	return 0
+}
+
+type GetSystemsInJumpRangeResponse struct {
+	// Systems which can be jumped to, excluding highsec and wormhole space, nearest first
+	Systems []*SystemDistance `protobuf:"bytes,1,rep,name=systems" json:"systems,omitempty"`
+}
+
+func (m *GetSystemsInJumpRangeResponse) Reset()                    { *m = GetSystemsInJumpRangeResponse{} }
+func (m *GetSystemsInJumpRangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetSystemsInJumpRangeResponse) ProtoMessage()               {}
+func (*GetSystemsInJumpRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *GetSystemsInJumpRangeResponse) GetSystems() []*SystemDistance {
+	if m != nil {
+		return m.Systems
+	}
+	return nil
+}
+
+type SystemDistance struct {
+	// The system's ID
+	SystemId int64 `protobuf:"varint,1,opt,name=system_id,json=systemId" json:"system_id,omitempty"`
+	// Direct distance to the system in light years
+	LightYears float64 `protobuf:"fixed64,2,opt,name=light_years,json=lightYears" json:"light_years,omitempty"`
+}
+
+func (m *SystemDistance) Reset()                    { *m = SystemDistance{} }
+func (m *SystemDistance) String() string            { return proto.CompactTextString(m) }
+func (*SystemDistance) ProtoMessage()               {}
+func (*SystemDistance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *SystemDistance) GetSystemId() int64 {
+	if m != nil {
+		return m.SystemId
+	}
+	return 0
+}
+
+func (m *SystemDistance) GetLightYears() float64 {
+	if m != nil {
+		return m.LightYears
+	}
+	return 0
+}
+
+type GetMarketTypesResponse struct {
+	// Locations retrieved
+	TypeIds []int32 `protobuf:"varint,1,rep,packed,name=type_ids,json=typeIds" json:"type_ids,omitempty"`
+}
+
+func (m *GetMarketTypesResponse) Reset()                    { *m = GetMarketTypesResponse{} }
+func (m *GetMarketTypesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMarketTypesResponse) ProtoMessage()               {}
+func (*GetMarketTypesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *GetMarketTypesResponse) GetTypeIds() []int32 {
+	if m != nil {
+		return m.TypeIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetLocationsRequest)(nil), "staticData.GetLocationsRequest")
+	proto.RegisterType((*GetLocationsResponse)(nil), "staticData.GetLocationsResponse")
+	proto.RegisterType((*LocationFailure)(nil), "staticData.LocationFailure")
+	proto.RegisterType((*SearchLocationsRequest)(nil), "staticData.SearchLocationsRequest")
+	proto.RegisterType((*SearchLocationsResponse)(nil), "staticData.SearchLocationsResponse")
+	proto.RegisterType((*SearchResult)(nil), "staticData.SearchResult")
+	proto.RegisterType((*ListChildrenRequest)(nil), "staticData.ListChildrenRequest")
+	proto.RegisterType((*ListStationsInRegionRequest)(nil), "staticData.ListStationsInRegionRequest")
+	proto.RegisterType((*ListLocationsResponse)(nil), "staticData.ListLocationsResponse")
+	proto.RegisterType((*FindNearbyRequest)(nil), "staticData.FindNearbyRequest")
+	proto.RegisterType((*FindNearbyResponse)(nil), "staticData.FindNearbyResponse")
+	proto.RegisterType((*NearbyLocation)(nil), "staticData.NearbyLocation")
+	proto.RegisterType((*GetStructureHistoryRequest)(nil), "staticData.GetStructureHistoryRequest")
+	proto.RegisterType((*GetStructureHistoryResponse)(nil), "staticData.GetStructureHistoryResponse")
+	proto.RegisterType((*StructureHistory)(nil), "staticData.StructureHistory")
+	proto.RegisterType((*StructureSnapshot)(nil), "staticData.StructureSnapshot")
+	proto.RegisterType((*StructureOverride)(nil), "staticData.StructureOverride")
+	proto.RegisterType((*OverrideAuditEntry)(nil), "staticData.OverrideAuditEntry")
+	proto.RegisterType((*UpsertStructureOverrideRequest)(nil), "staticData.UpsertStructureOverrideRequest")
+	proto.RegisterType((*DeleteStructureOverrideRequest)(nil), "staticData.DeleteStructureOverrideRequest")
+	proto.RegisterType((*ListStructureOverridesResponse)(nil), "staticData.ListStructureOverridesResponse")
+	proto.RegisterType((*Location)(nil), "staticData.Location")
+	proto.RegisterType((*Celestial)(nil), "staticData.Celestial")
+	proto.RegisterType((*Station)(nil), "staticData.Station")
+	proto.RegisterType((*Coordinates)(nil), "staticData.Coordinates")
+	proto.RegisterType((*SolarSystem)(nil), "staticData.SolarSystem")
+	proto.RegisterType((*Constellation)(nil), "staticData.Constellation")
+	proto.RegisterType((*Region)(nil), "staticData.Region")
+	proto.RegisterType((*GetRouteRequest)(nil), "staticData.GetRouteRequest")
+	proto.RegisterType((*GetRouteResponse)(nil), "staticData.GetRouteResponse")
+	proto.RegisterType((*GetDistanceRequest)(nil), "staticData.GetDistanceRequest")
+	proto.RegisterType((*GetDistanceResponse)(nil), "staticData.GetDistanceResponse")
+	proto.RegisterType((*GetSystemsInJumpRangeRequest)(nil), "staticData.GetSystemsInJumpRangeRequest")
+	proto.RegisterType((*GetSystemsInJumpRangeResponse)(nil), "staticData.GetSystemsInJumpRangeResponse")
+	proto.RegisterType((*SystemDistance)(nil), "staticData.SystemDistance")
+	proto.RegisterType((*GetMarketTypesResponse)(nil), "staticData.GetMarketTypesResponse")
+	proto.RegisterEnum("staticData.LocationKind", LocationKind_name, LocationKind_value)
+	proto.RegisterEnum("staticData.LocationFailure_Reason", LocationFailure_Reason_name, LocationFailure_Reason_value)
+	proto.RegisterEnum("staticData.StructureOverride_Visibility", StructureOverride_Visibility_name, StructureOverride_Visibility_value)
+	proto.RegisterEnum("staticData.OverrideAuditEntry_Action", OverrideAuditEntry_Action_name, OverrideAuditEntry_Action_value)
+	proto.RegisterEnum("staticData.Celestial_Kind", Celestial_Kind_name, Celestial_Kind_value)
+	proto.RegisterEnum("staticData.Station_Status", Station_Status_name, Station_Status_value)
+	proto.RegisterEnum("staticData.SolarSystem_WormholeClass", SolarSystem_WormholeClass_name, SolarSystem_WormholeClass_value)
+	proto.RegisterEnum("staticData.SolarSystem_WormholeEffect", SolarSystem_WormholeEffect_name, SolarSystem_WormholeEffect_value)
+	proto.RegisterEnum("staticData.GetRouteRequest_Preference", GetRouteRequest_Preference_name, GetRouteRequest_Preference_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
 var _ grpc.ClientConn
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for StaticData service
+
+type StaticDataClient interface {
+	GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error)
+	StreamLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (StaticData_StreamLocationsClient, error)
+	SearchLocations(ctx context.Context, in *SearchLocationsRequest, opts ...grpc.CallOption) (*SearchLocationsResponse, error)
+	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error)
+	ListStationsInRegion(ctx context.Context, in *ListStationsInRegionRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error)
+	FindNearby(ctx context.Context, in *FindNearbyRequest, opts ...grpc.CallOption) (*FindNearbyResponse, error)
+	GetStructureHistory(ctx context.Context, in *GetStructureHistoryRequest, opts ...grpc.CallOption) (*GetStructureHistoryResponse, error)
+	// Admin RPCs, these require the admin token passed as "authorization: Bearer <token>" metadata
+	UpsertStructureOverride(ctx context.Context, in *UpsertStructureOverrideRequest, opts ...grpc.CallOption) (*StructureOverride, error)
+	DeleteStructureOverride(ctx context.Context, in *DeleteStructureOverrideRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	ListStructureOverrides(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ListStructureOverridesResponse, error)
+	GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error)
+	GetDistance(ctx context.Context, in *GetDistanceRequest, opts ...grpc.CallOption) (*GetDistanceResponse, error)
+	GetSystemsInJumpRange(ctx context.Context, in *GetSystemsInJumpRangeRequest, opts ...grpc.CallOption) (*GetSystemsInJumpRangeResponse, error)
+	GetMarketTypes(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*GetMarketTypesResponse, error)
+}
+
+type staticDataClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStaticDataClient(cc *grpc.ClientConn) StaticDataClient {
+	return &staticDataClient{cc}
+}
+
+func (c *staticDataClient) GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error) {
+	out := new(GetLocationsResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/GetLocations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) StreamLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (StaticData_StreamLocationsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_StaticData_serviceDesc.Streams[0], c.cc, "/staticData.StaticData/StreamLocations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &staticDataStreamLocationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StaticData_StreamLocationsClient interface {
+	Recv() (*GetLocationsResponse, error)
+	grpc.ClientStream
+}
 
-// Client API for StaticData service
+type staticDataStreamLocationsClient struct {
+	grpc.ClientStream
+}
 
-type StaticDataClient interface {
-	GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error)
-	GetMarketTypes(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*GetMarketTypesResponse, error)
+func (x *staticDataStreamLocationsClient) Recv() (*GetLocationsResponse, error) {
+	m := new(GetLocationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type staticDataClient struct {
-	cc *grpc.ClientConn
+func (c *staticDataClient) SearchLocations(ctx context.Context, in *SearchLocationsRequest, opts ...grpc.CallOption) (*SearchLocationsResponse, error) {
+	out := new(SearchLocationsResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/SearchLocations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewStaticDataClient(cc *grpc.ClientConn) StaticDataClient {
-	return &staticDataClient{cc}
+func (c *staticDataClient) ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error) {
+	out := new(ListLocationsResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/ListChildren", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *staticDataClient) GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error) {
-	out := new(GetLocationsResponse)
-	err := grpc.Invoke(ctx, "/staticData.StaticData/GetLocations", in, out, c.cc, opts...)
+func (c *staticDataClient) ListStationsInRegion(ctx context.Context, in *ListStationsInRegionRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error) {
+	out := new(ListLocationsResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/ListStationsInRegion", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) FindNearby(ctx context.Context, in *FindNearbyRequest, opts ...grpc.CallOption) (*FindNearbyResponse, error) {
+	out := new(FindNearbyResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/FindNearby", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) GetStructureHistory(ctx context.Context, in *GetStructureHistoryRequest, opts ...grpc.CallOption) (*GetStructureHistoryResponse, error) {
+	out := new(GetStructureHistoryResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/GetStructureHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) UpsertStructureOverride(ctx context.Context, in *UpsertStructureOverrideRequest, opts ...grpc.CallOption) (*StructureOverride, error) {
+	out := new(StructureOverride)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/UpsertStructureOverride", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) DeleteStructureOverride(ctx context.Context, in *DeleteStructureOverrideRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/DeleteStructureOverride", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) ListStructureOverrides(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ListStructureOverridesResponse, error) {
+	out := new(ListStructureOverridesResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/ListStructureOverrides", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error) {
+	out := new(GetRouteResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/GetRoute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) GetDistance(ctx context.Context, in *GetDistanceRequest, opts ...grpc.CallOption) (*GetDistanceResponse, error) {
+	out := new(GetDistanceResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/GetDistance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataClient) GetSystemsInJumpRange(ctx context.Context, in *GetSystemsInJumpRangeRequest, opts ...grpc.CallOption) (*GetSystemsInJumpRangeResponse, error) {
+	out := new(GetSystemsInJumpRangeResponse)
+	err := grpc.Invoke(ctx, "/staticData.StaticData/GetSystemsInJumpRange", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -397,6 +2125,19 @@ func (c *staticDataClient) GetMarketTypes(ctx context.Context, in *google_protob
 
 type StaticDataServer interface {
 	GetLocations(context.Context, *GetLocationsRequest) (*GetLocationsResponse, error)
+	StreamLocations(*GetLocationsRequest, StaticData_StreamLocationsServer) error
+	SearchLocations(context.Context, *SearchLocationsRequest) (*SearchLocationsResponse, error)
+	ListChildren(context.Context, *ListChildrenRequest) (*ListLocationsResponse, error)
+	ListStationsInRegion(context.Context, *ListStationsInRegionRequest) (*ListLocationsResponse, error)
+	FindNearby(context.Context, *FindNearbyRequest) (*FindNearbyResponse, error)
+	GetStructureHistory(context.Context, *GetStructureHistoryRequest) (*GetStructureHistoryResponse, error)
+	// Admin RPCs, these require the admin token passed as "authorization: Bearer <token>" metadata
+	UpsertStructureOverride(context.Context, *UpsertStructureOverrideRequest) (*StructureOverride, error)
+	DeleteStructureOverride(context.Context, *DeleteStructureOverrideRequest) (*google_protobuf1.Empty, error)
+	ListStructureOverrides(context.Context, *google_protobuf1.Empty) (*ListStructureOverridesResponse, error)
+	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
+	GetDistance(context.Context, *GetDistanceRequest) (*GetDistanceResponse, error)
+	GetSystemsInJumpRange(context.Context, *GetSystemsInJumpRangeRequest) (*GetSystemsInJumpRangeResponse, error)
 	GetMarketTypes(context.Context, *google_protobuf1.Empty) (*GetMarketTypesResponse, error)
 }
 
@@ -422,6 +2163,225 @@ func _StaticData_GetLocations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaticData_StreamLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLocationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StaticDataServer).StreamLocations(m, &staticDataStreamLocationsServer{stream})
+}
+
+type StaticData_StreamLocationsServer interface {
+	Send(*GetLocationsResponse) error
+	grpc.ServerStream
+}
+
+type staticDataStreamLocationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *staticDataStreamLocationsServer) Send(m *GetLocationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StaticData_SearchLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).SearchLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/SearchLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).SearchLocations(ctx, req.(*SearchLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/ListChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).ListChildren(ctx, req.(*ListChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_ListStationsInRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStationsInRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).ListStationsInRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/ListStationsInRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).ListStationsInRegion(ctx, req.(*ListStationsInRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_FindNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).FindNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/FindNearby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).FindNearby(ctx, req.(*FindNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_GetStructureHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStructureHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).GetStructureHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/GetStructureHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).GetStructureHistory(ctx, req.(*GetStructureHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_UpsertStructureOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertStructureOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).UpsertStructureOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/UpsertStructureOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).UpsertStructureOverride(ctx, req.(*UpsertStructureOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_DeleteStructureOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStructureOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).DeleteStructureOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/DeleteStructureOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).DeleteStructureOverride(ctx, req.(*DeleteStructureOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_ListStructureOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf1.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).ListStructureOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/ListStructureOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).ListStructureOverrides(ctx, req.(*google_protobuf1.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_GetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).GetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/GetRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).GetRoute(ctx, req.(*GetRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_GetDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).GetDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/GetDistance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).GetDistance(ctx, req.(*GetDistanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticData_GetSystemsInJumpRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemsInJumpRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServer).GetSystemsInJumpRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/staticData.StaticData/GetSystemsInJumpRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServer).GetSystemsInJumpRange(ctx, req.(*GetSystemsInJumpRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StaticData_GetMarketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
@@ -448,59 +2408,264 @@ var _StaticData_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLocations",
 			Handler:    _StaticData_GetLocations_Handler,
 		},
+		{
+			MethodName: "SearchLocations",
+			Handler:    _StaticData_SearchLocations_Handler,
+		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _StaticData_ListChildren_Handler,
+		},
+		{
+			MethodName: "ListStationsInRegion",
+			Handler:    _StaticData_ListStationsInRegion_Handler,
+		},
+		{
+			MethodName: "FindNearby",
+			Handler:    _StaticData_FindNearby_Handler,
+		},
+		{
+			MethodName: "GetStructureHistory",
+			Handler:    _StaticData_GetStructureHistory_Handler,
+		},
+		{
+			MethodName: "UpsertStructureOverride",
+			Handler:    _StaticData_UpsertStructureOverride_Handler,
+		},
+		{
+			MethodName: "DeleteStructureOverride",
+			Handler:    _StaticData_DeleteStructureOverride_Handler,
+		},
+		{
+			MethodName: "ListStructureOverrides",
+			Handler:    _StaticData_ListStructureOverrides_Handler,
+		},
+		{
+			MethodName: "GetRoute",
+			Handler:    _StaticData_GetRoute_Handler,
+		},
+		{
+			MethodName: "GetDistance",
+			Handler:    _StaticData_GetDistance_Handler,
+		},
+		{
+			MethodName: "GetSystemsInJumpRange",
+			Handler:    _StaticData_GetSystemsInJumpRange_Handler,
+		},
 		{
 			MethodName: "GetMarketTypes",
 			Handler:    _StaticData_GetMarketTypes_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocations",
+			Handler:       _StaticData_StreamLocations_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "staticData.proto",
 }
 
 func init() { proto.RegisterFile("staticData.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xd6, 0xc6, 0x90, 0x9f, 0x71, 0x48, 0xd1, 0x82, 0x82, 0x09, 0x95, 0x08, 0xbe, 0x34, 0x42,
-	0x6d, 0xac, 0x86, 0x03, 0x3f, 0x97, 0x1e, 0x28, 0x42, 0x48, 0xa5, 0x87, 0x0d, 0xa7, 0x5e, 0x22,
-	0x13, 0x0f, 0x68, 0x85, 0xe3, 0x75, 0xbd, 0x6b, 0x84, 0x39, 0xf6, 0x15, 0xfa, 0x16, 0x7d, 0x83,
-	0x3e, 0x47, 0xdf, 0xa0, 0xea, 0x1b, 0xf4, 0x05, 0x2a, 0xaf, 0xed, 0xc4, 0x06, 0x2a, 0xb8, 0x79,
-	0xbe, 0x9f, 0x99, 0xf5, 0xcc, 0xec, 0xc2, 0xaa, 0x54, 0xae, 0xe2, 0xd3, 0x8f, 0xae, 0x72, 0x87,
-	0x61, 0x24, 0x94, 0xa0, 0xb0, 0x40, 0x7a, 0xaf, 0xaf, 0x85, 0xb8, 0xf6, 0xd1, 0x71, 0x43, 0xee,
-	0xb8, 0x41, 0x20, 0x52, 0x46, 0x04, 0x32, 0x53, 0xf6, 0xb6, 0x72, 0x56, 0x47, 0x97, 0xf1, 0x95,
-	0x83, 0xb3, 0x50, 0x25, 0x39, 0xb9, 0xfd, 0x90, 0x54, 0x7c, 0x86, 0x52, 0xb9, 0xb3, 0x30, 0x13,
-	0xd8, 0x07, 0xb0, 0x76, 0x8a, 0xea, 0x93, 0x98, 0x66, 0x39, 0x19, 0x7e, 0x8d, 0x51, 0x2a, 0xba,
-	0x03, 0x6d, 0x3f, 0xc7, 0x26, 0xdc, 0x93, 0x16, 0xe9, 0x1b, 0x03, 0x83, 0x99, 0x05, 0x76, 0xe6,
-	0x49, 0xfb, 0x27, 0x81, 0xf5, 0xaa, 0x55, 0x86, 0x22, 0x90, 0x48, 0xcf, 0xa1, 0x55, 0xe8, 0x32,
-	0xa3, 0x39, 0x72, 0x86, 0xa5, 0x1f, 0x7c, 0xca, 0x34, 0x9c, 0x23, 0x27, 0x81, 0x8a, 0x12, 0xb6,
-	0xc8, 0xd0, 0x63, 0xd0, 0xa9, 0x92, 0x74, 0x15, 0x8c, 0x1b, 0x4c, 0x2c, 0xd2, 0x27, 0x03, 0x83,
-	0xa5, 0x9f, 0x74, 0x17, 0x96, 0x6f, 0x5d, 0x3f, 0x46, 0xab, 0xd6, 0x27, 0x03, 0x73, 0xb4, 0x5e,
-	0x2e, 0x57, 0x98, 0x59, 0x26, 0x39, 0xaa, 0x1d, 0x10, 0xfb, 0x37, 0x81, 0x66, 0x81, 0xd3, 0x5d,
-	0xa8, 0x47, 0x78, 0xcd, 0x45, 0xa0, 0x33, 0x9a, 0x23, 0x5a, 0x76, 0x33, 0xcd, 0xb0, 0x5c, 0x41,
-	0x3f, 0xc0, 0xca, 0x54, 0x04, 0x52, 0xa1, 0xef, 0x6b, 0x73, 0x5e, 0x70, 0xb3, 0x6c, 0x39, 0x2e,
-	0x0b, 0x58, 0x55, 0x4f, 0x8f, 0xa0, 0x2d, 0x85, 0xef, 0x46, 0x13, 0x99, 0x48, 0x85, 0x33, 0xcb,
-	0xd0, 0xfe, 0x8d, 0xb2, 0x7f, 0x9c, 0xf2, 0x63, 0x4d, 0x33, 0x53, 0x2e, 0x02, 0xfa, 0x0e, 0x1a,
-	0x32, 0x9b, 0xbd, 0xb5, 0xa4, 0x6d, 0x6b, 0x15, 0x5b, 0x46, 0xb1, 0x42, 0x63, 0xff, 0xa8, 0x41,
-	0x23, 0x07, 0x69, 0x07, 0x6a, 0xdc, 0xcb, 0x3b, 0x56, 0xe3, 0x1e, 0xa5, 0xb0, 0x14, 0xb8, 0xb3,
-	0xac, 0x5f, 0x2d, 0xa6, 0xbf, 0xe9, 0x06, 0x34, 0x54, 0x12, 0xe2, 0x84, 0x7b, 0xfa, 0x54, 0x06,
-	0xab, 0xa7, 0xe1, 0x99, 0x47, 0xb7, 0xa0, 0xa5, 0x09, 0xed, 0x58, 0xd2, 0x8e, 0x66, 0x0a, 0x7c,
-	0x4e, 0x5d, 0xfb, 0xd0, 0xf2, 0x5d, 0xa9, 0x26, 0x12, 0x31, 0xb0, 0x96, 0xf5, 0xb1, 0x7a, 0xc3,
-	0x6c, 0xeb, 0x86, 0xc5, 0xd6, 0x0d, 0x2f, 0x8a, 0xad, 0x63, 0xcd, 0x54, 0x3c, 0x46, 0x0c, 0x68,
-	0x17, 0xea, 0x61, 0x7c, 0xe9, 0xf3, 0xa9, 0x55, 0xef, 0x93, 0x41, 0x93, 0xe5, 0x11, 0x3d, 0x04,
-	0xb8, 0xe2, 0x51, 0x91, 0xb1, 0xf1, 0x6c, 0xc6, 0x96, 0x56, 0xeb, 0x94, 0x87, 0x60, 0x4e, 0x85,
-	0x88, 0x3c, 0x1e, 0xb8, 0x0a, 0xa5, 0xd5, 0x7c, 0xdc, 0xdb, 0xe3, 0x05, 0xcd, 0xca, 0x5a, 0x7b,
-	0x1f, 0xcc, 0x12, 0x47, 0xdb, 0x40, 0xee, 0x74, 0xbb, 0x08, 0x23, 0x77, 0x69, 0x94, 0xe8, 0x56,
-	0x11, 0x46, 0x92, 0x34, 0xba, 0xd7, 0x1d, 0x22, 0x8c, 0xdc, 0xdb, 0x5f, 0xc0, 0x2c, 0x0d, 0xec,
-	0x51, 0xa3, 0xdf, 0xc0, 0x2b, 0x89, 0xd3, 0x38, 0xe2, 0x2a, 0x99, 0xa4, 0xe7, 0x88, 0x65, 0x9e,
-	0xa8, 0x53, 0xc0, 0x63, 0x8d, 0xce, 0x27, 0x62, 0x2c, 0x26, 0x62, 0xef, 0xc1, 0x4a, 0x65, 0x99,
-	0x5e, 0x32, 0x46, 0xfb, 0x2d, 0xd4, 0xb3, 0xa5, 0x7d, 0x91, 0x7a, 0x0f, 0xba, 0xa7, 0xa8, 0xce,
-	0xdd, 0xe8, 0x06, 0xd5, 0x45, 0x12, 0xe2, 0xe2, 0x1a, 0x6f, 0x42, 0x33, 0x5f, 0x87, 0xec, 0x16,
-	0x2f, 0xb3, 0x46, 0xb6, 0x0f, 0x72, 0xf4, 0x97, 0x00, 0x8c, 0xe7, 0x4d, 0xa5, 0x0a, 0xda, 0xe5,
-	0x3b, 0x4d, 0xb7, 0xff, 0x7f, 0xdb, 0xf5, 0xeb, 0xd2, 0xeb, 0x3f, 0xf7, 0x1c, 0xd8, 0x3b, 0xdf,
-	0x7e, 0xfd, 0xf9, 0x5e, 0xdb, 0xb2, 0xbb, 0xce, 0xed, 0x7b, 0x27, 0x0e, 0xf8, 0x2d, 0x46, 0x12,
-	0x9d, 0xf9, 0xa3, 0x70, 0x44, 0x76, 0xa9, 0x80, 0x4e, 0xf5, 0xe4, 0xb4, 0xfb, 0x68, 0x4b, 0x4e,
-	0xd2, 0xa7, 0xb0, 0x67, 0x3f, 0x28, 0xf7, 0xc4, 0xdf, 0x16, 0x05, 0xe9, 0x66, 0xa5, 0x60, 0xfa,
-	0xc3, 0xd2, 0x99, 0x69, 0xfd, 0x65, 0x5d, 0xa7, 0xdd, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff, 0x8e,
-	0x2a, 0x6e, 0x35, 0xad, 0x05, 0x00, 0x00,
+	// 3151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0x1b, 0xc9,
+	0xb1, 0x3b, 0xfc, 0x66, 0x51, 0xa2, 0xc6, 0x6d, 0xd9, 0xa2, 0x29, 0x5b, 0xd6, 0xce, 0x3e, 0x7f,
+	0xac, 0xb0, 0x4f, 0xf2, 0xca, 0xfb, 0xbc, 0x6b, 0xef, 0x3e, 0x2c, 0xc6, 0xd2, 0x48, 0xa6, 0x4d,
+	0x91, 0xda, 0x9e, 0x91, 0xbd, 0x7e, 0xc0, 0x03, 0x31, 0x26, 0x5b, 0xd2, 0x3c, 0x93, 0x33, 0xdc,
+	0xe9, 0xa1, 0xd6, 0x32, 0xf0, 0x82, 0x24, 0xd8, 0x63, 0x36, 0x09, 0x12, 0x20, 0xf9, 0x03, 0x39,
+	0x07, 0xb9, 0xe5, 0x90, 0x43, 0xf2, 0x0f, 0x02, 0x24, 0x7f, 0x20, 0x87, 0x1c, 0x02, 0xe4, 0x16,
+	0x20, 0xf7, 0xa0, 0x3f, 0x66, 0x38, 0xc3, 0x0f, 0x89, 0x4e, 0x9c, 0x13, 0xd5, 0x55, 0xd5, 0x55,
+	0xd5, 0xf5, 0xd5, 0xd5, 0x35, 0x02, 0x95, 0x06, 0x76, 0xe0, 0xb4, 0xb7, 0xed, 0xc0, 0x5e, 0xef,
+	0xfb, 0x5e, 0xe0, 0x21, 0x18, 0x42, 0xaa, 0x57, 0x8f, 0x3c, 0xef, 0xa8, 0x4b, 0x36, 0xec, 0xbe,
+	0xb3, 0x61, 0xbb, 0xae, 0xc7, 0x30, 0x9e, 0x4b, 0x05, 0x65, 0x75, 0x59, 0x62, 0xf9, 0xea, 0xc5,
+	0xe0, 0x70, 0x83, 0xf4, 0xfa, 0xc1, 0xa9, 0x44, 0x5e, 0x1f, 0x45, 0x06, 0x4e, 0x8f, 0xd0, 0xc0,
+	0xee, 0xf5, 0x05, 0x81, 0xf6, 0x09, 0x5c, 0xdc, 0x25, 0x41, 0xdd, 0x6b, 0x0b, 0x9e, 0x98, 0x7c,
+	0x35, 0x20, 0x34, 0x40, 0xef, 0xc2, 0x5c, 0x57, 0xc2, 0x5a, 0x4e, 0x87, 0x56, 0x94, 0xd5, 0xf4,
+	0xed, 0x34, 0x2e, 0x85, 0xb0, 0x5a, 0x87, 0x6a, 0xdf, 0xa6, 0x61, 0x31, 0xb9, 0x95, 0xf6, 0x3d,
+	0x97, 0x12, 0xb4, 0x07, 0xc5, 0x90, 0x4e, 0x6c, 0x2c, 0x6d, 0x6e, 0xac, 0xc7, 0x0e, 0x38, 0x69,
+	0xd3, 0x7a, 0x04, 0x31, 0xdc, 0xc0, 0x3f, 0xc5, 0x43, 0x0e, 0xe8, 0x31, 0x14, 0x0e, 0x6d, 0xa7,
+	0x3b, 0xf0, 0x09, 0xad, 0xa4, 0x38, 0xb7, 0xf5, 0x73, 0xb9, 0xed, 0xc8, 0x0d, 0x82, 0x59, 0xb4,
+	0x1f, 0x7d, 0x00, 0x88, 0x06, 0x76, 0x97, 0xb4, 0x12, 0x87, 0x4b, 0xf3, 0xc3, 0xa9, 0x1c, 0x53,
+	0x1f, 0x9e, 0xb0, 0x8a, 0xa1, 0x9c, 0x54, 0x0b, 0xa9, 0x90, 0x7e, 0x49, 0x4e, 0x2b, 0xca, 0xaa,
+	0x72, 0x3b, 0x8d, 0xd9, 0x9f, 0x68, 0x0d, 0xb2, 0x27, 0x76, 0x77, 0x40, 0x2a, 0xa9, 0x55, 0xe5,
+	0x76, 0x69, 0x73, 0x31, 0xae, 0x5a, 0xb8, 0x19, 0x0b, 0x92, 0x07, 0xa9, 0x4f, 0x94, 0xea, 0x97,
+	0x30, 0x9f, 0x50, 0x6e, 0x02, 0xcb, 0x0f, 0x93, 0x2c, 0x97, 0x27, 0xb1, 0x94, 0x3c, 0x62, 0x9c,
+	0xb5, 0x3f, 0x28, 0xb0, 0x30, 0x82, 0x46, 0x0f, 0x20, 0xe7, 0x13, 0x9b, 0x7a, 0x2e, 0xe7, 0x5f,
+	0xde, 0xd4, 0xce, 0xe0, 0xb5, 0x8e, 0x39, 0x25, 0x96, 0x3b, 0x50, 0x05, 0xf2, 0x3d, 0x42, 0xa9,
+	0x7d, 0x24, 0x14, 0x29, 0xe2, 0x70, 0xa9, 0x39, 0x90, 0x13, 0xb4, 0xa8, 0x04, 0xf9, 0x83, 0xc6,
+	0x93, 0x46, 0xf3, 0x59, 0x43, 0x7d, 0x07, 0x5d, 0x80, 0xf9, 0x5a, 0xe3, 0xa9, 0x5e, 0xaf, 0x6d,
+	0xb7, 0xb0, 0xde, 0xd8, 0x35, 0x54, 0x05, 0x5d, 0x82, 0x0b, 0x12, 0xdf, 0x32, 0x2d, 0x7c, 0xb0,
+	0x65, 0x1d, 0x60, 0x43, 0x4d, 0x21, 0x04, 0xe5, 0x83, 0x7d, 0xd3, 0xc2, 0x86, 0xbe, 0xd7, 0x32,
+	0x30, 0x6e, 0x62, 0x35, 0x8d, 0x16, 0x41, 0x8d, 0x60, 0x56, 0x6d, 0xcf, 0x68, 0x1e, 0x58, 0x6a,
+	0x46, 0xfb, 0x7b, 0x0a, 0x2e, 0x9b, 0xc4, 0xf6, 0xdb, 0xc7, 0x63, 0x21, 0xba, 0x08, 0xd9, 0xaf,
+	0x06, 0xc4, 0x17, 0xa6, 0x2b, 0x62, 0xb1, 0x60, 0xd0, 0xc3, 0xc1, 0xeb, 0xd7, 0xa7, 0x5c, 0xe7,
+	0x02, 0x16, 0x0b, 0xb4, 0x0e, 0xd9, 0x97, 0x8e, 0x2b, 0x5d, 0x5d, 0xde, 0xac, 0x4c, 0x32, 0xc3,
+	0x13, 0xc7, 0xed, 0x60, 0x41, 0x86, 0x96, 0xa1, 0xe8, 0x93, 0x23, 0x11, 0x1f, 0x95, 0x0c, 0x77,
+	0x4d, 0x41, 0x00, 0x6a, 0x1d, 0x26, 0xa2, 0xeb, 0xf4, 0x9c, 0xa0, 0x92, 0x5d, 0x55, 0x6e, 0x67,
+	0xb1, 0x58, 0xa0, 0x7d, 0x50, 0xbf, 0xf6, 0xfc, 0xde, 0xb1, 0xd7, 0x25, 0xad, 0x76, 0xd7, 0xa6,
+	0x94, 0xd0, 0x4a, 0x8e, 0x4b, 0xbb, 0x11, 0x97, 0x66, 0x7a, 0x5d, 0xdb, 0x37, 0x4f, 0x69, 0x40,
+	0x7a, 0xeb, 0xcf, 0x24, 0xfd, 0x16, 0x23, 0xc7, 0x0b, 0x5f, 0xc7, 0x97, 0x84, 0xa2, 0x5b, 0xb0,
+	0xe0, 0xb8, 0xed, 0xee, 0xa0, 0x43, 0x5a, 0x3e, 0xe9, 0x79, 0x27, 0xa4, 0x53, 0xc9, 0xf3, 0x43,
+	0x95, 0x25, 0x18, 0x0b, 0x28, 0xfa, 0x22, 0x26, 0x9a, 0x1c, 0x1e, 0x92, 0x76, 0x40, 0x2b, 0x05,
+	0x2e, 0xfa, 0xe6, 0x79, 0xa2, 0x0d, 0x4e, 0x3e, 0x94, 0x2d, 0xd6, 0x54, 0xdb, 0x83, 0xa5, 0x31,
+	0xb3, 0xcb, 0xf4, 0xde, 0x84, 0xbc, 0x4f, 0xe8, 0xa0, 0x1b, 0x84, 0xc9, 0x9d, 0xb0, 0xa6, 0xd8,
+	0x85, 0x39, 0x01, 0x0e, 0x09, 0xb5, 0xff, 0x87, 0xb9, 0x38, 0x02, 0x95, 0x21, 0xe5, 0x74, 0x64,
+	0xcc, 0xa7, 0x9c, 0x0e, 0x42, 0x90, 0x71, 0xed, 0x5e, 0x18, 0x68, 0xfc, 0x6f, 0xf4, 0x01, 0x64,
+	0x98, 0x33, 0x2a, 0x69, 0x1e, 0xb9, 0xd3, 0x5d, 0xc6, 0xa9, 0xce, 0xf4, 0x98, 0xf6, 0xab, 0x14,
+	0x5c, 0xac, 0x3b, 0x34, 0xd8, 0x3a, 0x76, 0xba, 0x1d, 0x9f, 0xb8, 0x61, 0x08, 0x2d, 0x43, 0xb1,
+	0x6f, 0xfb, 0xc4, 0x0d, 0x5a, 0x91, 0x36, 0x05, 0x01, 0xa8, 0x75, 0x86, 0x31, 0x93, 0x9a, 0x2d,
+	0x66, 0x26, 0x05, 0x40, 0xfa, 0x6d, 0x07, 0x40, 0x66, 0xe6, 0x00, 0xc8, 0xfe, 0x6b, 0x01, 0xf0,
+	0x8d, 0x02, 0xcb, 0xcc, 0x64, 0xa6, 0xbc, 0x6c, 0x6a, 0x2e, 0xe6, 0xd6, 0x8c, 0x99, 0x6e, 0x68,
+	0x6f, 0x65, 0x24, 0x43, 0xae, 0x43, 0xa9, 0x3f, 0x78, 0xd1, 0x75, 0xda, 0x2d, 0xcf, 0xed, 0x86,
+	0xa9, 0x08, 0x02, 0xd4, 0x74, 0xbb, 0xa7, 0x93, 0x4e, 0x96, 0x9e, 0x74, 0x32, 0xed, 0x37, 0x0a,
+	0x5c, 0x62, 0x6a, 0x8c, 0x87, 0x61, 0x63, 0xfc, 0x96, 0xb9, 0x93, 0x70, 0xd1, 0xa4, 0x5d, 0xd3,
+	0xaf, 0x99, 0x7f, 0x47, 0xb1, 0xd7, 0x7e, 0x97, 0x82, 0x0b, 0x3b, 0x8e, 0xdb, 0x69, 0x10, 0xdb,
+	0x7f, 0x71, 0x1a, 0x33, 0x9d, 0xe7, 0x3b, 0x47, 0x4e, 0xdc, 0x74, 0x02, 0x50, 0xeb, 0xa0, 0xfb,
+	0x50, 0x6a, 0x7b, 0x9e, 0xdf, 0x71, 0x5c, 0x3b, 0x20, 0x54, 0x0a, 0x5a, 0x8a, 0x0b, 0xda, 0x1a,
+	0xa2, 0x71, 0x9c, 0xf6, 0x8d, 0x8b, 0xdc, 0x88, 0x97, 0x32, 0x63, 0x5e, 0xba, 0x09, 0x0b, 0x3d,
+	0xfb, 0x55, 0xab, 0xe3, 0xd0, 0xc0, 0x76, 0xdb, 0xa4, 0x65, 0x0f, 0x78, 0xc9, 0x53, 0xf0, 0x7c,
+	0xcf, 0x7e, 0xb5, 0x2d, 0xa1, 0xfa, 0x80, 0x1d, 0x88, 0xd1, 0xfd, 0xdf, 0xa0, 0xd7, 0x67, 0x35,
+	0x8f, 0x15, 0xc5, 0x42, 0xcf, 0x7e, 0xf5, 0x98, 0xad, 0x87, 0xd5, 0x32, 0x1f, 0xaf, 0x96, 0x13,
+	0x02, 0xa0, 0x30, 0x31, 0x00, 0x1e, 0x03, 0x8a, 0x5b, 0x50, 0x3a, 0xff, 0xa3, 0xd1, 0x1a, 0x54,
+	0x8d, 0x1f, 0x56, 0x10, 0x47, 0x0e, 0x89, 0xaa, 0xd0, 0xaf, 0x15, 0x28, 0x27, 0x71, 0x63, 0x85,
+	0x28, 0x2c, 0x3a, 0xa9, 0x99, 0x8a, 0xce, 0x22, 0x64, 0xc5, 0xa1, 0xd3, 0xe2, 0x6c, 0x7c, 0xc1,
+	0xec, 0x1a, 0x37, 0x59, 0x86, 0x9b, 0x0c, 0x3a, 0x43, 0x7b, 0xdd, 0x81, 0x42, 0x18, 0x77, 0x95,
+	0xec, 0x19, 0x91, 0x14, 0x51, 0x69, 0x3a, 0x54, 0x77, 0x49, 0x60, 0x06, 0xfe, 0xa0, 0x1d, 0x0c,
+	0x7c, 0xf2, 0xc8, 0xa1, 0x81, 0xe7, 0x47, 0x01, 0xf5, 0x1e, 0xcc, 0xd3, 0x10, 0x15, 0xeb, 0xd6,
+	0xe6, 0x22, 0x20, 0x6b, 0xd7, 0x7e, 0xaf, 0xc0, 0xf2, 0x44, 0x1e, 0xd2, 0xa4, 0x16, 0x14, 0x8f,
+	0x39, 0xc8, 0x21, 0xa1, 0x51, 0xef, 0x8d, 0xf4, 0x59, 0xd3, 0xf6, 0xae, 0x3f, 0x0a, 0x37, 0xca,
+	0xac, 0x8a, 0x18, 0x55, 0xff, 0x07, 0xca, 0x49, 0xe4, 0x84, 0xac, 0xda, 0x4c, 0x66, 0xd5, 0xd5,
+	0x44, 0xc9, 0x1a, 0x15, 0x19, 0xcb, 0xae, 0x26, 0xa8, 0xa3, 0x68, 0xf4, 0x29, 0x14, 0xa9, 0x6b,
+	0xf7, 0xe9, 0xb1, 0x17, 0x85, 0xc6, 0xb5, 0x89, 0xfc, 0x4c, 0x49, 0x85, 0x87, 0xf4, 0xda, 0x0f,
+	0x53, 0x70, 0x61, 0x8c, 0x00, 0x7d, 0x0a, 0x25, 0xef, 0x05, 0x25, 0xfe, 0x09, 0xe9, 0xb4, 0xec,
+	0x80, 0x2b, 0xce, 0xe2, 0x4d, 0x34, 0xd6, 0xeb, 0x61, 0x63, 0xbd, 0x6e, 0x85, 0x8d, 0x35, 0x86,
+	0x90, 0x5c, 0x0f, 0x26, 0x5e, 0x6c, 0x4b, 0x90, 0x0f, 0x4e, 0xfb, 0xcc, 0x53, 0x3c, 0x6e, 0xd2,
+	0x38, 0xc7, 0x96, 0x35, 0x7e, 0x87, 0x71, 0x04, 0xdf, 0x91, 0xe1, 0x3b, 0x0a, 0x0c, 0xd0, 0x60,
+	0xbb, 0x2e, 0x43, 0x4e, 0xa4, 0x26, 0x0f, 0x99, 0x02, 0x96, 0x2b, 0x96, 0xa4, 0x94, 0xd5, 0xf5,
+	0x16, 0xe5, 0x85, 0x9d, 0x71, 0xcd, 0x71, 0xae, 0xf3, 0x74, 0x58, 0xee, 0x6b, 0x1d, 0xb4, 0x09,
+	0x39, 0x66, 0x87, 0x01, 0xe5, 0x89, 0x58, 0x4e, 0x66, 0x8c, 0xac, 0xf2, 0xfc, 0x77, 0x40, 0xb1,
+	0xa4, 0xd4, 0x7e, 0x9b, 0x8e, 0x19, 0xa4, 0x79, 0x42, 0x7c, 0xdf, 0xe9, 0x10, 0xf6, 0x36, 0x88,
+	0x87, 0x9b, 0x74, 0x65, 0x29, 0x16, 0x6d, 0x6f, 0xf1, 0xd8, 0x13, 0x8e, 0x97, 0x9d, 0x74, 0xbc,
+	0x91, 0xba, 0x99, 0x7b, 0x83, 0xba, 0xf9, 0x08, 0xe0, 0xc4, 0xa1, 0xce, 0x0b, 0xa7, 0xeb, 0x04,
+	0xa7, 0xd2, 0x3a, 0xb7, 0x27, 0x06, 0x4d, 0x68, 0x82, 0xf5, 0xa7, 0x11, 0x3d, 0x8e, 0xed, 0x45,
+	0x2b, 0x00, 0x74, 0xd0, 0xef, 0xfb, 0x84, 0xd2, 0xa8, 0xa0, 0xc5, 0x20, 0xe8, 0x73, 0x28, 0xd9,
+	0x83, 0x8e, 0x13, 0xb4, 0x02, 0xdf, 0x76, 0xba, 0x95, 0x22, 0x8f, 0xcf, 0x95, 0xb8, 0xa8, 0x50,
+	0x82, 0xce, 0xc8, 0x44, 0x36, 0x01, 0xdf, 0x62, 0xb1, 0x1d, 0xda, 0x06, 0xc0, 0x50, 0x34, 0x2a,
+	0x40, 0xe6, 0x89, 0x61, 0xec, 0xab, 0xef, 0x20, 0x80, 0xdc, 0xfe, 0xc1, 0xc3, 0x7a, 0x6d, 0x4b,
+	0x55, 0x58, 0x4f, 0xbe, 0x8f, 0x6b, 0x4f, 0x75, 0xcb, 0x50, 0x53, 0xda, 0x9f, 0x14, 0x40, 0xe3,
+	0x3c, 0x59, 0x30, 0xd9, 0x83, 0xe0, 0xd8, 0xf3, 0x65, 0xf3, 0x2c, 0x57, 0x0c, 0x2e, 0xdf, 0x0b,
+	0xc2, 0x73, 0x72, 0x85, 0xee, 0x03, 0xb4, 0x8f, 0x6d, 0xf7, 0x48, 0xa4, 0x40, 0xfa, 0xdc, 0x14,
+	0x28, 0x4a, 0x6a, 0x3d, 0x40, 0xff, 0x0d, 0x39, 0xbb, 0xcd, 0x4b, 0x5d, 0x66, 0x55, 0x19, 0x6d,
+	0x86, 0xc6, 0x55, 0x5b, 0xd7, 0x39, 0x31, 0x96, 0x9b, 0xb4, 0x55, 0xc8, 0x09, 0x08, 0x3b, 0xe3,
+	0xc1, 0xbe, 0x69, 0x60, 0x4b, 0x9c, 0x77, 0xdb, 0xa8, 0x1b, 0x96, 0xa1, 0x2a, 0xda, 0xf7, 0x14,
+	0x58, 0x39, 0xe8, 0x53, 0xe2, 0x07, 0x63, 0x7e, 0x0a, 0x0b, 0xe4, 0x7d, 0x28, 0x78, 0x12, 0x24,
+	0xf3, 0xf7, 0xda, 0x99, 0xfe, 0xc5, 0x11, 0x79, 0xcc, 0x22, 0xe9, 0xb8, 0x45, 0x1e, 0x67, 0x0a,
+	0x29, 0x35, 0x1d, 0xda, 0x4d, 0x73, 0x60, 0x65, 0x9b, 0x74, 0x49, 0x40, 0xa6, 0xaa, 0x30, 0x43,
+	0xd2, 0xcc, 0x26, 0xea, 0x7f, 0x61, 0x45, 0xf4, 0x65, 0x23, 0x82, 0x86, 0x9d, 0xd1, 0xa7, 0x50,
+	0x0c, 0xd5, 0x3f, 0xbb, 0x06, 0x46, 0x3a, 0x0e, 0xe9, 0xb5, 0x9f, 0xa7, 0xa0, 0x10, 0xdd, 0x8e,
+	0x6b, 0x90, 0x13, 0x3d, 0x9d, 0xb4, 0x1a, 0x8a, 0xb3, 0x91, 0xfd, 0xa0, 0xa4, 0x40, 0x9f, 0xc3,
+	0x7c, 0xdb, 0x73, 0x69, 0x40, 0xba, 0x5d, 0x71, 0xb3, 0x89, 0x6a, 0x7e, 0x25, 0x99, 0x82, 0x31,
+	0x02, 0x9c, 0xa4, 0x47, 0x0f, 0x60, 0x2e, 0x9e, 0xe9, 0x95, 0xf4, 0x78, 0x0a, 0xc7, 0x1a, 0x58,
+	0x5c, 0x8a, 0xe5, 0x3f, 0xfa, 0x4f, 0xc8, 0xd3, 0xc0, 0x8e, 0xa2, 0xac, 0xb4, 0x79, 0x71, 0x42,
+	0x75, 0xc3, 0x21, 0x0d, 0xba, 0x0b, 0xc5, 0x36, 0xe9, 0x12, 0x1a, 0x38, 0x76, 0x57, 0xde, 0xc0,
+	0x97, 0x12, 0x7a, 0x86, 0x48, 0x3c, 0xa4, 0xd3, 0xfe, 0x9a, 0x82, 0x62, 0x84, 0x98, 0xe9, 0x05,
+	0xb3, 0x9e, 0x78, 0xc1, 0x54, 0x27, 0x4a, 0x58, 0x8f, 0xb5, 0x13, 0xb1, 0x0a, 0x99, 0x49, 0x54,
+	0xc8, 0x91, 0xe2, 0x96, 0x7d, 0x83, 0xe2, 0xb6, 0x09, 0x97, 0x3a, 0x4c, 0x92, 0x2b, 0xc6, 0x1d,
+	0xa3, 0x97, 0xc4, 0xc5, 0x18, 0x32, 0xaa, 0xa5, 0xf7, 0x60, 0x29, 0xb1, 0x27, 0xb0, 0xfd, 0x23,
+	0x3b, 0xe0, 0x7a, 0xe5, 0xf9, 0xae, 0x38, 0x4b, 0x53, 0x62, 0x6b, 0xac, 0x57, 0xcb, 0xb0, 0xd3,
+	0x24, 0xa7, 0x02, 0xac, 0x34, 0xd5, 0xf5, 0x86, 0x61, 0xa9, 0x0a, 0x2b, 0x58, 0x7b, 0xcd, 0x66,
+	0x43, 0x4d, 0xb1, 0x59, 0x81, 0x6e, 0x5a, 0x06, 0x6e, 0xd6, 0xb6, 0x5b, 0x0f, 0x8d, 0xba, 0xa5,
+	0xa6, 0xd1, 0x1c, 0x14, 0x4c, 0x4b, 0xc7, 0xbb, 0xac, 0x70, 0x65, 0xb4, 0x5f, 0xe4, 0x20, 0x2f,
+	0xfd, 0x36, 0x93, 0xad, 0xff, 0xb9, 0xdb, 0xe5, 0x63, 0x28, 0x76, 0x6d, 0x1a, 0xb4, 0x28, 0x21,
+	0x61, 0x2b, 0x76, 0x56, 0x59, 0x2b, 0x30, 0x62, 0x93, 0x10, 0x37, 0x76, 0x1b, 0xe7, 0x12, 0xb7,
+	0xf1, 0x7d, 0x80, 0x43, 0xc7, 0x0f, 0x39, 0xe6, 0xcf, 0x2f, 0x94, 0x9c, 0x9a, 0xb3, 0x1c, 0x71,
+	0x72, 0xe1, 0x0d, 0x9c, 0x7c, 0x05, 0x0a, 0xde, 0xd7, 0x2e, 0xf1, 0xd9, 0xe9, 0x8b, 0xfc, 0xf4,
+	0x79, 0xbe, 0xae, 0x75, 0xd0, 0x35, 0x00, 0x81, 0xe2, 0xe7, 0x07, 0x7e, 0xfe, 0x22, 0x87, 0x34,
+	0xa4, 0xd9, 0x7c, 0xbb, 0xcd, 0xcd, 0x56, 0x12, 0x66, 0x63, 0xcb, 0x5a, 0x07, 0x55, 0xa1, 0xc0,
+	0x9a, 0x18, 0xa7, 0x4d, 0x68, 0x65, 0x6e, 0x35, 0xcd, 0xac, 0x16, 0xae, 0xd1, 0xc7, 0xb0, 0xe4,
+	0x93, 0xbe, 0xef, 0xb5, 0x09, 0xa5, 0x8e, 0x7b, 0xc4, 0x9e, 0x9c, 0x4e, 0xdb, 0x21, 0x6e, 0xfb,
+	0xb4, 0x32, 0xcf, 0x9b, 0xdd, 0xcb, 0x71, 0xb4, 0x11, 0x61, 0xd1, 0x67, 0x50, 0x4d, 0x6c, 0x94,
+	0xf9, 0x48, 0x5b, 0x81, 0xfd, 0x92, 0x54, 0xca, 0x7c, 0x6f, 0x25, 0x4e, 0x11, 0xbe, 0x3e, 0x2d,
+	0xfb, 0x25, 0x73, 0x56, 0x85, 0x3f, 0x47, 0xbc, 0xf6, 0x4b, 0xfb, 0x45, 0x97, 0xb4, 0xe8, 0xb1,
+	0xd3, 0x6f, 0x9d, 0x78, 0xdd, 0x41, 0x8f, 0x54, 0x16, 0xf8, 0xde, 0x4b, 0xec, 0x5d, 0x22, 0xd1,
+	0xe6, 0xb1, 0xd3, 0x7f, 0xca, 0x91, 0x6c, 0xea, 0xe7, 0x31, 0x25, 0xd8, 0x5b, 0xc3, 0x0d, 0xec,
+	0x6e, 0xab, 0xed, 0xd1, 0xa0, 0xa2, 0xf2, 0x2d, 0xaa, 0xc0, 0x60, 0x8e, 0xd8, 0xf2, 0x68, 0x10,
+	0x6b, 0x94, 0x2e, 0xcc, 0xda, 0x28, 0x31, 0xb7, 0xcb, 0x67, 0x0c, 0xbb, 0x1f, 0xd1, 0xf9, 0x6e,
+	0x97, 0xd4, 0x7a, 0xa0, 0x7d, 0x06, 0x39, 0xc1, 0x2c, 0x99, 0x36, 0x05, 0xc8, 0xd4, 0x6b, 0x4f,
+	0x0d, 0x71, 0x9f, 0x63, 0x63, 0xaf, 0xf9, 0xd4, 0xd8, 0x56, 0x53, 0xa8, 0x0c, 0x60, 0x1e, 0xec,
+	0xef, 0x63, 0xc3, 0x34, 0x8d, 0x6d, 0x35, 0xad, 0x7d, 0x0c, 0xa5, 0x58, 0x54, 0xa0, 0x39, 0x50,
+	0x5e, 0xf1, 0x44, 0x51, 0xb0, 0xf2, 0x8a, 0xad, 0xc4, 0xe3, 0x5b, 0xc1, 0xca, 0x29, 0x5b, 0xbd,
+	0xe6, 0xb9, 0xa1, 0x60, 0xe5, 0xb5, 0xf6, 0x97, 0x2c, 0x94, 0x62, 0xe5, 0x74, 0x2c, 0xc7, 0x6e,
+	0xc1, 0x02, 0x25, 0xed, 0x81, 0xef, 0x04, 0xa7, 0x2d, 0x69, 0x0e, 0xc1, 0xa9, 0x1c, 0x82, 0xa5,
+	0xd6, 0x61, 0x32, 0xa6, 0x63, 0xc9, 0x38, 0x12, 0xca, 0x99, 0x37, 0x08, 0xe5, 0x25, 0x5e, 0xc9,
+	0xfd, 0x61, 0x9f, 0xc7, 0x4c, 0xcc, 0x02, 0xf9, 0x06, 0x44, 0x92, 0xc5, 0x78, 0x85, 0x67, 0x5e,
+	0x11, 0xcf, 0x87, 0x50, 0x3e, 0x35, 0x61, 0xf1, 0xde, 0xef, 0xda, 0x2e, 0x09, 0xf8, 0x43, 0x28,
+	0xcf, 0x1f, 0x42, 0x45, 0x01, 0xa9, 0x75, 0x28, 0xaa, 0x43, 0x39, 0x39, 0xa4, 0xa9, 0x14, 0xc6,
+	0xbb, 0x92, 0xe9, 0x23, 0x9a, 0xf9, 0xc4, 0x88, 0x06, 0x35, 0x61, 0x61, 0x64, 0xee, 0xc2, 0xd3,
+	0x6f, 0xf6, 0xb1, 0x4b, 0x39, 0x39, 0x76, 0x61, 0xda, 0x1f, 0x8a, 0xc6, 0x87, 0x19, 0x00, 0xb8,
+	0x01, 0x8a, 0x12, 0x52, 0xeb, 0x88, 0x26, 0x22, 0x2a, 0xc6, 0xb4, 0x52, 0x12, 0x53, 0x79, 0x1a,
+	0x95, 0x60, 0xaa, 0xbd, 0x82, 0xf9, 0x84, 0xca, 0x48, 0x85, 0xb9, 0x46, 0xd3, 0x6a, 0x3d, 0x6b,
+	0xe2, 0xbd, 0x47, 0xcd, 0xba, 0xa1, 0xbe, 0x83, 0x72, 0x90, 0xda, 0xfa, 0x50, 0x55, 0xf8, 0xef,
+	0xa6, 0x9a, 0xe2, 0xbf, 0x77, 0xd5, 0x34, 0xff, 0xfd, 0x48, 0xcd, 0xf0, 0xdf, 0xff, 0x52, 0xb3,
+	0xfc, 0xf7, 0x9e, 0x9a, 0x43, 0x45, 0xc8, 0x5a, 0x8f, 0x0c, 0xac, 0xab, 0x79, 0x34, 0x0f, 0x45,
+	0xf3, 0x91, 0x6e, 0x59, 0x06, 0x36, 0xb6, 0xd5, 0x02, 0x0b, 0xcd, 0x6d, 0x5c, 0xdb, 0xb1, 0x0c,
+	0xac, 0x16, 0xb5, 0x9f, 0x29, 0x50, 0x4e, 0x1e, 0x8f, 0xcf, 0x79, 0xe5, 0xf8, 0xd7, 0xd8, 0xd9,
+	0x31, 0xb6, 0xac, 0xb0, 0x55, 0xad, 0x9b, 0x3a, 0x56, 0x15, 0x56, 0xf2, 0xf7, 0xf4, 0xdd, 0x86,
+	0x61, 0xe9, 0x58, 0xc4, 0xf6, 0xc3, 0xba, 0xbe, 0xf5, 0xa4, 0xc5, 0xf5, 0x4c, 0xb3, 0xf5, 0xb3,
+	0x66, 0x7d, 0xa7, 0x85, 0xf5, 0xe7, 0x86, 0xa5, 0x66, 0x50, 0x05, 0x16, 0xb7, 0x74, 0x4b, 0xdf,
+	0xaa, 0x3f, 0x37, 0xf7, 0x6a, 0x5b, 0xad, 0xa7, 0x3a, 0xae, 0xe9, 0x0f, 0xeb, 0x86, 0x9a, 0x65,
+	0x6a, 0x61, 0x63, 0xbb, 0xb5, 0x5b, 0xd3, 0x1b, 0x96, 0x9a, 0x63, 0xcb, 0x46, 0x33, 0x94, 0x98,
+	0xd7, 0xbe, 0x55, 0x60, 0x3e, 0xd1, 0x79, 0xcc, 0x74, 0xa1, 0x8c, 0xc4, 0x70, 0xfa, 0x0d, 0x62,
+	0x38, 0xe9, 0xc5, 0xcc, 0x88, 0x17, 0xb5, 0x1e, 0x1b, 0x9f, 0x1f, 0xcd, 0xaa, 0xc7, 0x2a, 0x94,
+	0x3a, 0x84, 0xb6, 0x7d, 0xa7, 0xcf, 0xb6, 0xcb, 0x34, 0x8b, 0x83, 0xce, 0x13, 0xf7, 0x37, 0x05,
+	0x16, 0x76, 0x49, 0x80, 0xbd, 0x41, 0x40, 0x66, 0x1a, 0x41, 0xdd, 0x80, 0x72, 0xfc, 0xfa, 0x77,
+	0xc4, 0x34, 0x24, 0x8d, 0xe7, 0x63, 0xd0, 0x5a, 0x07, 0xed, 0x00, 0xf4, 0x7d, 0x72, 0x48, 0x7c,
+	0xe2, 0xb6, 0x49, 0x25, 0x3d, 0x1e, 0xf7, 0x23, 0x42, 0xd7, 0xf7, 0x23, 0x6a, 0x1c, 0xdb, 0xc9,
+	0x74, 0xb1, 0x4f, 0x3c, 0xa7, 0xc3, 0x23, 0x3a, 0xc3, 0x23, 0xba, 0xc0, 0x01, 0x2c, 0x9c, 0xef,
+	0x01, 0x0c, 0xb7, 0xf1, 0x16, 0xe1, 0x51, 0x13, 0x5b, 0x86, 0xc9, 0x22, 0xa9, 0x08, 0x59, 0x53,
+	0xdf, 0x31, 0x58, 0x20, 0x2d, 0x40, 0xa9, 0x6e, 0x98, 0x66, 0xcb, 0x34, 0xb6, 0xf8, 0x17, 0x06,
+	0x6d, 0x17, 0xd4, 0xa1, 0x78, 0xd9, 0x17, 0x5f, 0x03, 0x88, 0xda, 0x9f, 0x70, 0x46, 0x52, 0xa4,
+	0xb2, 0xe9, 0xa1, 0xc3, 0x61, 0x4e, 0x2a, 0x36, 0xcc, 0xd1, 0xbe, 0x04, 0xb4, 0x4b, 0x82, 0x70,
+	0xd8, 0xf5, 0x16, 0xed, 0xa7, 0xdd, 0x83, 0x8b, 0x09, 0xce, 0x52, 0xcb, 0xeb, 0x50, 0xea, 0x3a,
+	0x47, 0xc7, 0x41, 0xeb, 0x94, 0xd8, 0x3e, 0x95, 0xc5, 0x1c, 0x38, 0xe8, 0x39, 0x83, 0x68, 0x5f,
+	0xc0, 0x55, 0x36, 0x8b, 0xe1, 0x7a, 0xd3, 0x9a, 0xcb, 0xa6, 0x6c, 0x98, 0x3d, 0xb6, 0x66, 0xd2,
+	0x6d, 0x11, 0xb2, 0x3e, 0x23, 0x96, 0xc5, 0x5c, 0x2c, 0xb4, 0x03, 0xb8, 0x36, 0x85, 0xe5, 0x70,
+	0xde, 0x26, 0x0c, 0x35, 0x71, 0xde, 0x26, 0x36, 0x46, 0x27, 0x09, 0x49, 0xb5, 0x06, 0x94, 0x93,
+	0x28, 0xa6, 0xdb, 0xb0, 0x03, 0x95, 0xba, 0x85, 0x1e, 0x18, 0x3d, 0x79, 0x6a, 0xec, 0xe4, 0x77,
+	0xe1, 0xf2, 0x2e, 0x09, 0xf6, 0x6c, 0xff, 0x25, 0x09, 0xac, 0xd3, 0x7e, 0xec, 0xc9, 0x73, 0x05,
+	0x0a, 0xb2, 0xfb, 0x13, 0x0a, 0x66, 0x71, 0x5e, 0xb4, 0x7f, 0x74, 0x8d, 0xc2, 0x5c, 0x7c, 0x72,
+	0x87, 0xf2, 0x90, 0xd6, 0x1b, 0xcf, 0x45, 0x21, 0xc2, 0xc6, 0x6e, 0xad, 0xd9, 0x50, 0x15, 0xd6,
+	0x8e, 0x6e, 0x35, 0x1b, 0xa6, 0x65, 0xd4, 0xeb, 0xba, 0x55, 0xe3, 0x1d, 0xaa, 0x0a, 0x73, 0x66,
+	0xb3, 0xae, 0xe3, 0x96, 0xf9, 0xdc, 0xb4, 0x8c, 0x3d, 0x35, 0xcd, 0xaa, 0x9d, 0x69, 0x09, 0x74,
+	0x86, 0x57, 0xc2, 0xe8, 0x8b, 0x16, 0xaf, 0x40, 0x5b, 0x46, 0xdd, 0x30, 0xad, 0x9a, 0x5e, 0x57,
+	0x73, 0x9b, 0xbf, 0x64, 0xf7, 0x74, 0x64, 0x20, 0x14, 0xc0, 0x5c, 0xfc, 0x33, 0x25, 0xba, 0x3e,
+	0xfd, 0x03, 0x26, 0xf7, 0x61, 0x75, 0xf5, 0xbc, 0x2f, 0x9c, 0xda, 0xbb, 0xdf, 0xff, 0xe3, 0x9f,
+	0x7f, 0x9a, 0x5a, 0x7e, 0xa0, 0xac, 0x69, 0x97, 0x37, 0x4e, 0x3e, 0xdc, 0x18, 0xb8, 0xce, 0x09,
+	0xf1, 0x29, 0xd9, 0x18, 0x7e, 0x38, 0xfd, 0xae, 0x02, 0x0b, 0x66, 0xe0, 0x13, 0xbb, 0xf7, 0x56,
+	0x25, 0xdf, 0xe6, 0x92, 0x35, 0x26, 0xf9, 0xda, 0x64, 0xc9, 0x1b, 0x94, 0x4b, 0xbd, 0xa3, 0xa0,
+	0x6f, 0x98, 0x0a, 0xc9, 0xef, 0x48, 0x48, 0x1b, 0xff, 0x5c, 0x34, 0xa6, 0xc5, 0x7b, 0x67, 0xd2,
+	0xbc, 0x81, 0x22, 0x7c, 0x2b, 0xfa, 0x0e, 0xcc, 0xc5, 0x3f, 0xff, 0x24, 0xad, 0x30, 0xe1, 0xc3,
+	0x50, 0xf5, 0xdd, 0x73, 0xbf, 0x24, 0x68, 0x6b, 0x5c, 0xfa, 0x7f, 0x30, 0xe9, 0xd7, 0xa7, 0x48,
+	0x6f, 0x87, 0xf2, 0x7e, 0xa0, 0xc0, 0xe2, 0xa4, 0x8f, 0x29, 0xe8, 0xd6, 0xa8, 0x9c, 0x29, 0x9f,
+	0x5b, 0x66, 0x51, 0x68, 0xaa, 0x39, 0xc4, 0x03, 0x9d, 0x79, 0x45, 0x7a, 0x60, 0x00, 0x30, 0x9c,
+	0xa9, 0xa3, 0xc4, 0x6c, 0x60, 0xec, 0x6b, 0x45, 0x75, 0x65, 0x1a, 0x7a, 0x76, 0x2f, 0xb8, 0x42,
+	0xd0, 0x4f, 0x14, 0x5e, 0xf1, 0xc6, 0x66, 0xb6, 0x37, 0xcf, 0x1d, 0x33, 0x0b, 0x4d, 0x6e, 0xcd,
+	0x38, 0x8e, 0x9e, 0xee, 0x9a, 0x68, 0xda, 0x42, 0x37, 0x8e, 0xa5, 0xf0, 0x1f, 0x29, 0xb0, 0x34,
+	0x65, 0x7a, 0x84, 0xd6, 0xe2, 0x02, 0xcf, 0x1e, 0x31, 0x55, 0xcf, 0x9e, 0xb0, 0x68, 0xef, 0x73,
+	0x95, 0xde, 0x7b, 0xa0, 0xac, 0x55, 0x57, 0x98, 0x4a, 0x76, 0xa7, 0xe7, 0xb8, 0x71, 0x7d, 0xa2,
+	0x09, 0x0c, 0xd7, 0x68, 0xca, 0x30, 0x29, 0xa9, 0xd1, 0xd9, 0x13, 0xa7, 0xea, 0xe5, 0xb1, 0xf7,
+	0x87, 0xc1, 0xfe, 0x31, 0x44, 0xbb, 0xc3, 0x55, 0x59, 0x63, 0xd6, 0xb9, 0x71, 0xb6, 0x2a, 0x1b,
+	0x1d, 0x2e, 0x81, 0x65, 0xf1, 0xe5, 0xc9, 0x33, 0x27, 0x34, 0x45, 0x48, 0x75, 0x6d, 0x3c, 0xb0,
+	0xa7, 0xcd, 0xab, 0xb4, 0x9b, 0x5c, 0xa1, 0x55, 0x74, 0x9e, 0x61, 0x3a, 0x50, 0x08, 0xef, 0x74,
+	0xb4, 0x7c, 0x46, 0xa3, 0x51, 0xbd, 0x3a, 0x19, 0x29, 0xc5, 0x5d, 0xe3, 0xe2, 0x96, 0xd8, 0xf9,
+	0x51, 0x32, 0x4f, 0x38, 0xe7, 0x3e, 0x94, 0x62, 0xd7, 0x32, 0x5a, 0x19, 0xe1, 0x35, 0xd2, 0x09,
+	0x54, 0xaf, 0x4f, 0xc5, 0x4b, 0x71, 0xab, 0x5c, 0x5c, 0x95, 0x89, 0xbb, 0x94, 0x10, 0x17, 0x7e,
+	0x10, 0x42, 0x3f, 0x56, 0xe0, 0xd2, 0xc4, 0xeb, 0x17, 0xdd, 0x1e, 0x8d, 0xf8, 0x69, 0x97, 0x7e,
+	0xf5, 0xfd, 0x19, 0x28, 0xa5, 0x42, 0x1a, 0x57, 0xe8, 0x2a, 0x53, 0x68, 0x29, 0xa1, 0x10, 0x6b,
+	0x78, 0x5a, 0xbc, 0x21, 0x40, 0x1e, 0x94, 0x93, 0x37, 0xed, 0x54, 0x47, 0x6b, 0x23, 0x82, 0x27,
+	0xdc, 0xce, 0xe1, 0x5d, 0x85, 0xae, 0x24, 0xc4, 0xb1, 0x0b, 0x9a, 0x6e, 0xf4, 0x38, 0xfd, 0x8b,
+	0x1c, 0x67, 0x7b, 0xf7, 0x1f, 0x03, 0x00, 0x6d, 0xdc, 0xee, 0x3e, 0x09, 0x25, 0x00, 0x00,
 }
@@ -2,13 +2,16 @@ package types
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"github.com/EVE-Tools/static-data/lib/httpcache"
 	pb "github.com/EVE-Tools/static-data/lib/staticData"
 	"github.com/antihax/goesi"
 	"github.com/boltdb/bolt"
 	"github.com/golang/protobuf/proto"
 	google_pb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/mailru/easyjson"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -44,6 +47,9 @@ var db *bolt.DB
 var esiClient *goesi.APIClient
 var esiSemaphore chan struct{}
 
+// Expiry of type info if ESI does not send an Expires header
+const defaultTTL = 24 * time.Hour
+
 // Initialize initializes infrastructure for market types
 func Initialize(esi *goesi.APIClient, database *bolt.DB) {
 	db = database
@@ -53,6 +59,8 @@ func Initialize(esi *goesi.APIClient, database *bolt.DB) {
 	// Initialize buckets
 	err := db.Update(func(tx *bolt.Tx) error {
 		tx.CreateBucketIfNotExists([]byte("marketTypes"))
+		tx.CreateBucketIfNotExists([]byte("typeInfo"))
+		tx.CreateBucketIfNotExists([]byte("typePages"))
 		return nil
 	})
 	if err != nil {
@@ -81,7 +89,7 @@ func updateMarketTypes() {
 	logrus.Info("Updating market types...")
 
 	// Get all type IDs
-	ids, err := getMarketTypes()
+	ids, err := getMarketTypes(context.Background())
 	if err != nil {
 		logrus.WithError(err).Warn("could not update market types")
 		return
@@ -111,12 +119,11 @@ func updateMarketTypes() {
 }
 
 // Get all typeIDs from ESI
-func getTypeIDs() ([]int32, error) {
+func getTypeIDs(ctx context.Context) ([]int32, error) {
 	var typeIDs []int32
-	params := make(map[string]interface{})
-	params["page"] = int32(1)
+	page := int32(1)
 
-	typeResult, _, err := esiClient.ESI.UniverseApi.GetUniverseTypes(nil, params)
+	typeResult, err := getTypeIDPage(ctx, page)
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +131,8 @@ func getTypeIDs() ([]int32, error) {
 	typeIDs = append(typeIDs, typeResult...)
 
 	for len(typeResult) > 0 {
-		params["page"] = params["page"].(int32) + 1
-		typeResult, _, err = esiClient.ESI.UniverseApi.GetUniverseTypes(nil, params)
+		page++
+		typeResult, err = getTypeIDPage(ctx, page)
 		if err != nil {
 			return nil, err
 		}
@@ -136,9 +143,45 @@ func getTypeIDs() ([]int32, error) {
 	return typeIDs, nil
 }
 
+// Get a page of type IDs from cache or ESI, expired pages are only transferred again if they were modified
+func getTypeIDPage(ctx context.Context, page int32) ([]int32, error) {
+	var cachedPage CachedTypePage
+	err := getFromCache("typePages", strconv.Itoa(int(page)), &cachedPage)
+	if err != nil {
+		return nil, err
+	}
+
+	if cachedPage.ExpiresAt > time.Now().Unix() {
+		return cachedPage.TypeIDs, nil
+	}
+
+	params := make(map[string]interface{})
+	params["page"] = page
+
+	typeResult, response, err := esiClient.ESI.UniverseApi.GetUniverseTypes(httpcache.WithETag(ctx, cachedPage.ETag), params)
+	if httpcache.NotModified(response) {
+		typeResult = cachedPage.TypeIDs
+	} else if err != nil {
+		return nil, err
+	} else {
+		cachedPage.ETag = httpcache.ETag(response)
+	}
+
+	cachedPage.Page = page
+	cachedPage.TypeIDs = typeResult
+	cachedPage.ExpiresAt = httpcache.ExpiresAt(response, defaultTTL)
+
+	err = putIntoCache("typePages", strconv.Itoa(int(page)), &cachedPage)
+	if err != nil {
+		logrus.WithError(err).Warn("could not store type ID page")
+	}
+
+	return typeResult, nil
+}
+
 // Get all types on market
-func getMarketTypes() ([]int32, error) {
-	typeIDs, err := getTypeIDs()
+func getMarketTypes(ctx context.Context) ([]int32, error) {
+	typeIDs, err := getTypeIDs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +193,7 @@ func getMarketTypes() ([]int32, error) {
 	typesLeft := len(typeIDs)
 
 	for _, id := range typeIDs {
-		go checkIfMarketTypeAsyncRetry(id, marketTypes, nonMarketTypes, failure)
+		go checkIfMarketTypeAsyncRetry(ctx, id, marketTypes, nonMarketTypes, failure)
 	}
 
 	var marketTypeIDs []int32
@@ -171,13 +214,13 @@ func getMarketTypes() ([]int32, error) {
 }
 
 // Async check if market type, retry 3 times
-func checkIfMarketTypeAsyncRetry(typeID int32, marketTypes chan int32, nonMarketTypes chan int32, failure chan error) {
+func checkIfMarketTypeAsyncRetry(ctx context.Context, typeID int32, marketTypes chan int32, nonMarketTypes chan int32, failure chan error) {
 	var isMarketType bool
 	var err error
 	retries := 3
 
 	for retries > 0 {
-		isMarketType, err = checkIfMarketType(typeID)
+		isMarketType, err = checkIfMarketType(ctx, typeID)
 		if err != nil {
 			logrus.WithError(err).Warn("error loading type info")
 			retries--
@@ -201,18 +244,79 @@ func checkIfMarketTypeAsyncRetry(typeID int32, marketTypes chan int32, nonMarket
 }
 
 // Check if type is market type
-func checkIfMarketType(typeID int32) (bool, error) {
+func checkIfMarketType(ctx context.Context, typeID int32) (bool, error) {
+	key := strconv.Itoa(int(typeID))
+
+	var cachedType CachedType
+	err := getFromCache("typeInfo", key, &cachedType)
+	if err != nil {
+		return false, err
+	}
+
+	if cachedType.ExpiresAt > time.Now().Unix() {
+		return cachedType.IsMarketType, nil
+	}
+
+	// Only transferred again if modified
+	conditionalCtx := httpcache.WithETag(ctx, cachedType.ETag)
+
 	esiSemaphore <- struct{}{}
-	typeInfo, _, err := esiClient.ESI.UniverseApi.GetUniverseTypesTypeId(nil, typeID, nil)
+	typeInfo, response, err := esiClient.ESI.UniverseApi.GetUniverseTypesTypeId(conditionalCtx, typeID, nil)
 	<-esiSemaphore
+	if !httpcache.NotModified(response) {
+		if err != nil {
+			return false, err
+		}
+
+		// If it is published and has a market group it is a market type!
+		cachedType.IsMarketType = typeInfo.Published && (typeInfo.MarketGroupId != 0)
+		cachedType.ETag = httpcache.ETag(response)
+	}
+
+	cachedType.ID = typeID
+	cachedType.ExpiresAt = httpcache.ExpiresAt(response, defaultTTL)
+
+	err = putIntoCache("typeInfo", key, &cachedType)
 	if err != nil {
-		return false, err
+		logrus.WithError(err).Warn("could not store type info")
+	}
+
+	return cachedType.IsMarketType, nil
+}
+
+// Load an entry from a bucket, leave it untouched if there is none
+func getFromCache(bucketName string, key string, entry easyjson.Unmarshaler) error {
+	var blob []byte
+	db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(bucketName))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		blob = bucket.Get([]byte(key))
+		return nil
+	})
+
+	if blob == nil {
+		return nil
 	}
 
-	// If it is published and has a market group it is a market type!
-	if typeInfo.Published && (typeInfo.MarketGroupId != 0) {
-		return true, nil
+	return easyjson.Unmarshal(blob, entry)
+}
+
+// Store an entry in a bucket, writes of concurrent checks are batched
+func putIntoCache(bucketName string, key string, entry easyjson.Marshaler) error {
+	blob, err := easyjson.Marshal(entry)
+	if err != nil {
+		return err
 	}
 
-	return false, nil
+	return db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(bucketName))
+		if bucket == nil {
+			panic("Bucket not found! This should never happen!")
+		}
+
+		return bucket.Put([]byte(key), blob)
+	})
 }
@@ -0,0 +1,23 @@
+package types
+
+//
+// Own types
+//
+
+// CachedType represents a type's market status cache entry with expiration date.
+//easyjson:json
+type CachedType struct {
+	ID           int32  `json:"id"`
+	IsMarketType bool   `json:"isMarketType"`
+	ExpiresAt    int64  `json:"expiresAt"`
+	ETag         string `json:"etag,omitempty"`
+}
+
+// CachedTypePage represents a page of ESI's type ID list with expiration date.
+//easyjson:json
+type CachedTypePage struct {
+	Page      int32   `json:"page"`
+	TypeIDs   []int32 `json:"typeIds"`
+	ExpiresAt int64   `json:"expiresAt"`
+	ETag      string  `json:"etag,omitempty"`
+}
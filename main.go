@@ -1,6 +1,7 @@
 package main
 
 import (
+	"expvar"
 	"fmt"
 	"log"
 	"net"
@@ -12,7 +13,9 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/EVE-Tools/element43/go/lib/transport"
+	"github.com/EVE-Tools/static-data/lib/httpcache"
 	"github.com/EVE-Tools/static-data/lib/locations"
+	"github.com/EVE-Tools/static-data/lib/navigation"
 	"github.com/EVE-Tools/static-data/lib/server"
 	pb "github.com/EVE-Tools/static-data/lib/staticData"
 	"github.com/EVE-Tools/static-data/lib/types"
@@ -27,16 +30,29 @@ import (
 
 // Config holds the application's configuration info from the environment.
 type Config struct {
-	DBPath            string `default:"static-data.db" envconfig:"db_path"`
-	LogLevel          string `default:"info" envconfig:"log_level"`
-	Port              string `default:"43000" envconfig:"port"`
-	ESIHost           string `default:"esi.tech.ccp.is" envconfig:"esi_host"`
-	StructureHuntHost string `default:"stop.hammerti.me.uk" envconfig:"structure_hunt_host"`
-	DisableTLS        bool   `default:"false" envconfig:"disable_tls"`
+	DBPath               string            `default:"static-data.db" envconfig:"db_path"`
+	LogLevel             string            `default:"info" envconfig:"log_level"`
+	Port                 string            `default:"43000" envconfig:"port"`
+	MetricsPort          string            `default:"43001" envconfig:"metrics_port"`
+	ESIHost              string            `default:"esi.tech.ccp.is" envconfig:"esi_host"`
+	StructureHuntHost    string            `default:"stop.hammerti.me.uk" envconfig:"structure_hunt_host"`
+	DisableTLS           bool              `default:"false" envconfig:"disable_tls"`
+	LocationWorkers      int               `default:"100" envconfig:"location_workers"`
+	LocationCacheSize    int               `default:"100000" envconfig:"location_cache_size"`
+	StaleWhileRevalidate bool              `default:"false" envconfig:"stale_while_revalidate"`
+	CrawlInterval        time.Duration     `default:"1h" envconfig:"crawl_interval"`
+	CrawlConcurrency     int               `default:"10" envconfig:"crawl_concurrency"`
+	SDEPath              string            `default:"" envconfig:"sde_path"`
+	SSOClientID          string            `default:"" envconfig:"sso_client_id"`
+	SSOClientSecret      string            `default:"" envconfig:"sso_client_secret"`
+	SSORefreshToken      string            `default:"" envconfig:"sso_refresh_token"`
+	SSOTokenURL          string            `default:"" envconfig:"sso_token_url"`
+	AdminTokens          map[string]string `default:"" envconfig:"admin_tokens"`
 }
 
 func main() {
 	config := loadConfig()
+	startMetricsEndpoint(config)
 	startEndpoint(config)
 
 	// Terminate this goroutine, crash if all other goroutines exited
@@ -54,7 +70,45 @@ func loadConfig() Config {
 	}
 
 	logrus.SetLevel(logLevel)
-	logrus.Debugf("Config: %q", config)
+	logrus.Debugf("Config: %+v", config.redacted())
+
+	err = config.validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return config
+}
+
+// Check settings which would otherwise stall the service, a pool without workers never runs any jobs
+func (config Config) validate() error {
+	if config.LocationWorkers < 1 {
+		return fmt.Errorf("LOCATION_WORKERS must be at least 1, got %d", config.LocationWorkers)
+	}
+
+	if config.CrawlConcurrency < 1 {
+		return fmt.Errorf("CRAWL_CONCURRENCY must be at least 1, got %d", config.CrawlConcurrency)
+	}
+
+	return nil
+}
+
+// Get a copy of the configuration which is safe to be logged, secrets are masked
+func (config Config) redacted() Config {
+	secrets := []*string{&config.SSOClientSecret, &config.SSORefreshToken}
+	for _, secret := range secrets {
+		if *secret != "" {
+			*secret = "<redacted>"
+		}
+	}
+
+	// Admin names are fine to log, their tokens are not
+	adminTokens := make(map[string]string, len(config.AdminTokens))
+	for admin := range config.AdminTokens {
+		adminTokens[admin] = "<redacted>"
+	}
+	config.AdminTokens = adminTokens
+
 	return config
 }
 
@@ -72,7 +126,7 @@ func getClients(config Config) (*goesi.APIClient, *http.Client, string) {
 
 	httpClientESI := &http.Client{
 		Timeout:   timeout,
-		Transport: transport.NewESITransport(userAgent, timeout),
+		Transport: httpcache.NewTransport(transport.NewESITransport(userAgent, timeout)),
 	}
 
 	esiClient := goesi.NewAPIClient(httpClientESI, userAgent)
@@ -88,6 +142,33 @@ func getClients(config Config) (*goesi.APIClient, *http.Client, string) {
 	return esiClient, genericClient, structureHuntURL
 }
 
+// getStructureAuth returns the SSO credentials for resolving structures, nil if no refresh token is configured
+func getStructureAuth(config Config) *locations.StructureAuth {
+	if config.SSORefreshToken == "" {
+		return nil
+	}
+
+	return &locations.StructureAuth{
+		ClientID:     config.SSOClientID,
+		ClientSecret: config.SSOClientSecret,
+		RefreshToken: config.SSORefreshToken,
+		TokenURL:     config.SSOTokenURL,
+	}
+}
+
+// Serve metrics published via expvar in background.
+func startMetricsEndpoint(config Config) {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	go func() {
+		err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%s", config.MetricsPort), mux)
+		if err != nil {
+			logrus.WithError(err).Error("could not serve metrics")
+		}
+	}()
+}
+
 // Init DB and start gRPC endpoint.
 func startEndpoint(config Config) {
 	db, err := bolt.Open(config.DBPath, 0600, &bolt.Options{Timeout: 1 * time.Second})
@@ -100,15 +181,29 @@ func startEndpoint(config Config) {
 	locations.Initialize(esiClient,
 		genericClient,
 		url,
-		db)
+		db,
+		locations.Options{
+			PoolSize:             config.LocationWorkers,
+			CacheSize:            config.LocationCacheSize,
+			StaleWhileRevalidate: config.StaleWhileRevalidate,
+			CrawlInterval:        config.CrawlInterval,
+			CrawlConcurrency:     config.CrawlConcurrency,
+			SDEPath:              config.SDEPath,
+			StructureAuth:        getStructureAuth(config),
+		})
 
 	types.Initialize(esiClient, db)
+	navigation.Initialize(db, locations.GetStargateGraph)
 
 	var opts []grpc.ServerOption
 	var logOpts []grpc_logrus.Option
+	logEntry := logrus.NewEntry(logrus.New())
 	opts = append(opts, grpc_middleware.WithUnaryServerChain(
 		grpc_ctxtags.UnaryServerInterceptor(),
-		grpc_logrus.UnaryServerInterceptor(logrus.NewEntry(logrus.New()), logOpts...)))
+		grpc_logrus.UnaryServerInterceptor(logEntry, logOpts...)))
+	opts = append(opts, grpc_middleware.WithStreamServerChain(
+		grpc_ctxtags.StreamServerInterceptor(),
+		grpc_logrus.StreamServerInterceptor(logEntry, logOpts...)))
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%s", config.Port))
 
@@ -117,6 +212,6 @@ func startEndpoint(config Config) {
 	}
 
 	grpcServer := grpc.NewServer(opts...)
-	pb.RegisterStaticDataServer(grpcServer, &server.Server{})
+	pb.RegisterStaticDataServer(grpcServer, &server.Server{AdminTokens: config.AdminTokens})
 	grpcServer.Serve(listener)
 }